@@ -91,3 +91,15 @@ func TestCheckNoResourceAttr(name, key string) resource.TestCheckFunc {
 	// Deprecated: use `check.That(name).Key(key).DoesNotExist()` instead
 	return resource.TestCheckNoResourceAttr(name, key)
 }
+
+// TestCheckTypeSetElemNestedAttrs is a TestCheckFunc which validates that an element of a set or list in state has
+// all the given nested attribute values, regardless of its position
+func TestCheckTypeSetElemNestedAttrs(name, attr string, values map[string]string) resource.TestCheckFunc {
+	return resource.TestCheckTypeSetElemNestedAttrs(name, attr, values)
+}
+
+// TestCheckTypeSetElemAttrPair is a TestCheckFunc which validates that an element of a set or list in state matches
+// the value of an attribute of another resource
+func TestCheckTypeSetElemAttrPair(nameFirst, keyFirst, nameSecond, keySecond string) resource.TestCheckFunc {
+	return resource.TestCheckTypeSetElemAttrPair(nameFirst, keyFirst, nameSecond, keySecond)
+}
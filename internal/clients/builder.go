@@ -63,6 +63,8 @@ func (b *ClientBuilder) Build(ctx context.Context) (*Client, error) {
 		TerraformVersion: client.TerraformVersion,
 	}
 
+	client.UserAgent = o.ProviderUserAgent()
+
 	if err := client.build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building client: %+v", err)
 	}
@@ -46,6 +46,7 @@ type Client struct {
 	Claims      *claims.Claims
 
 	TerraformVersion string
+	UserAgent        string
 
 	StopContext context.Context
 
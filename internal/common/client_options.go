@@ -106,6 +106,11 @@ Request ID: %s
 	return resp, nil
 }
 
+// ProviderUserAgent returns the user agent to use for requests which are not made with a Microsoft Graph client
+func (o ClientOptions) ProviderUserAgent() string {
+	return o.userAgent("")
+}
+
 func (o ClientOptions) userAgent(sdkUserAgent string) (userAgent string) {
 	tfUserAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s", o.TerraformVersion, meta.SDKVersionString()) //nolint:staticcheck
 	providerUserAgent := fmt.Sprintf("%s terraform-provider-azuread/%s", tfUserAgent, version.ProviderVersion)
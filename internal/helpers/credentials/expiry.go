@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+)
+
+const CredentialTypePassword = "Password"
+
+// ExpiringCredential describes a password or key credential that is due to expire
+type ExpiringCredential struct {
+	DisplayName   string
+	EndDateTime   time.Time
+	KeyId         string
+	StartDateTime *time.Time
+	Type          string
+	Usage         string
+}
+
+// Expired returns whether the credential had already expired at the specified time
+func (c ExpiringCredential) Expired(at time.Time) bool {
+	return !c.EndDateTime.After(at)
+}
+
+// ExpiringPasswordCredentials returns all password credentials that expire before the specified cutoff. Credentials
+// with no end date never expire and are not returned. An error is returned when a start or end date cannot be parsed.
+func ExpiringPasswordCredentials(passwordCredentials *[]stable.PasswordCredential, cutoff time.Time) ([]ExpiringCredential, error) {
+	result := make([]ExpiringCredential, 0)
+	if passwordCredentials == nil {
+		return result, nil
+	}
+
+	for _, cred := range *passwordCredentials {
+		expiring := ExpiringCredential{
+			DisplayName: cred.DisplayName.GetOrZero(),
+			KeyId:       cred.KeyId.GetOrZero(),
+			Type:        CredentialTypePassword,
+		}
+
+		ok, err := expiringCredentialDates(&expiring, cred.StartDateTime.GetOrZero(), cred.EndDateTime.GetOrZero(), cutoff)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, expiring)
+		}
+	}
+
+	return result, nil
+}
+
+// ExpiringKeyCredentials returns all key credentials, including token signing certificates, that expire before the
+// specified cutoff. Credentials with no end date never expire and are not returned. An error is returned when a start
+// or end date cannot be parsed.
+func ExpiringKeyCredentials(keyCredentials *[]stable.KeyCredential, cutoff time.Time) ([]ExpiringCredential, error) {
+	result := make([]ExpiringCredential, 0)
+	if keyCredentials == nil {
+		return result, nil
+	}
+
+	for _, cred := range *keyCredentials {
+		expiring := ExpiringCredential{
+			DisplayName: cred.DisplayName.GetOrZero(),
+			KeyId:       cred.KeyId.GetOrZero(),
+			Type:        cred.Type.GetOrZero(),
+			Usage:       cred.Usage.GetOrZero(),
+		}
+
+		ok, err := expiringCredentialDates(&expiring, cred.StartDateTime.GetOrZero(), cred.EndDateTime.GetOrZero(), cutoff)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, expiring)
+		}
+	}
+
+	return result, nil
+}
+
+// expiringCredentialDates parses the start and end dates of a credential, using the same RFC3339 format that is used
+// when creating credentials, and returns whether the credential expires before the cutoff
+func expiringCredentialDates(expiring *ExpiringCredential, startDateTime, endDateTime string, cutoff time.Time) (bool, error) {
+	if endDateTime == "" {
+		return false, nil
+	}
+
+	end, err := time.Parse(time.RFC3339, endDateTime)
+	if err != nil {
+		return false, CredentialError{str: fmt.Sprintf("Unable to parse the end date %q for credential with key ID %q: %+v", endDateTime, expiring.KeyId, err), attr: "end_date"}
+	}
+	if end.After(cutoff) {
+		return false, nil
+	}
+	expiring.EndDateTime = end
+
+	if startDateTime != "" {
+		start, err := time.Parse(time.RFC3339, startDateTime)
+		if err != nil {
+			return false, CredentialError{str: fmt.Sprintf("Unable to parse the start date %q for credential with key ID %q: %+v", startDateTime, expiring.KeyId, err), attr: "start_date"}
+		}
+		expiring.StartDateTime = &start
+	}
+
+	return true, nil
+}
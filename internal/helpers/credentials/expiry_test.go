@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+)
+
+func TestExpiringPasswordCredentials(t *testing.T) {
+	cutoff := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	passwordCredential := func(keyId, startDateTime, endDateTime string) stable.PasswordCredential {
+		cred := stable.PasswordCredential{
+			DisplayName: nullable.Value("test"),
+			KeyId:       nullable.Value(keyId),
+		}
+		if startDateTime != "" {
+			cred.StartDateTime = nullable.Value(startDateTime)
+		}
+		if endDateTime != "" {
+			cred.EndDateTime = nullable.Value(endDateTime)
+		}
+		return cred
+	}
+
+	cases := []struct {
+		TestName    string
+		Credentials *[]stable.PasswordCredential
+		Expected    []string
+		ExpectError string
+	}{
+		{
+			TestName:    "Nil",
+			Credentials: nil,
+			Expected:    []string{},
+		},
+		{
+			TestName: "BeforeCutoff",
+			Credentials: &[]stable.PasswordCredential{
+				passwordCredential("a", "2025-06-01T00:00:00Z", "2026-05-31T23:59:59Z"),
+			},
+			Expected: []string{"a"},
+		},
+		{
+			TestName: "AtCutoff",
+			Credentials: &[]stable.PasswordCredential{
+				passwordCredential("a", "", "2026-06-01T00:00:00Z"),
+			},
+			Expected: []string{"a"},
+		},
+		{
+			TestName: "AfterCutoff",
+			Credentials: &[]stable.PasswordCredential{
+				passwordCredential("a", "", "2026-06-01T00:00:01Z"),
+			},
+			Expected: []string{},
+		},
+		{
+			TestName: "AlreadyExpired",
+			Credentials: &[]stable.PasswordCredential{
+				passwordCredential("a", "", "2020-01-01T00:00:00Z"),
+			},
+			Expected: []string{"a"},
+		},
+		{
+			TestName: "NoEndDate",
+			Credentials: &[]stable.PasswordCredential{
+				passwordCredential("a", "2025-06-01T00:00:00Z", ""),
+			},
+			Expected: []string{},
+		},
+		{
+			TestName: "Mixed",
+			Credentials: &[]stable.PasswordCredential{
+				passwordCredential("a", "", "2026-01-01T00:00:00Z"),
+				passwordCredential("b", "", "2027-01-01T00:00:00Z"),
+				passwordCredential("c", "", "2026-05-01T00:00:00+02:00"),
+			},
+			Expected: []string{"a", "c"},
+		},
+		{
+			TestName: "InvalidEndDate",
+			Credentials: &[]stable.PasswordCredential{
+				passwordCredential("a", "", "not-a-date"),
+			},
+			ExpectError: "end_date",
+		},
+		{
+			TestName: "InvalidStartDate",
+			Credentials: &[]stable.PasswordCredential{
+				passwordCredential("a", "not-a-date", "2026-01-01T00:00:00Z"),
+			},
+			ExpectError: "start_date",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			result, err := ExpiringPasswordCredentials(tc.Credentials, cutoff)
+			checkExpiringCredentials(t, result, err, tc.Expected, tc.ExpectError)
+			for _, cred := range result {
+				if cred.Type != CredentialTypePassword {
+					t.Fatalf("expected type %q, got %q", CredentialTypePassword, cred.Type)
+				}
+			}
+		})
+	}
+}
+
+func TestExpiringKeyCredentials(t *testing.T) {
+	cutoff := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	keyCredential := func(keyId, usage, endDateTime string) stable.KeyCredential {
+		cred := stable.KeyCredential{
+			KeyId: nullable.Value(keyId),
+			Type:  nullable.Value("AsymmetricX509Cert"),
+			Usage: nullable.Value(usage),
+		}
+		if endDateTime != "" {
+			cred.EndDateTime = nullable.Value(endDateTime)
+		}
+		return cred
+	}
+
+	cases := []struct {
+		TestName    string
+		Credentials *[]stable.KeyCredential
+		Expected    []string
+		ExpectError string
+	}{
+		{
+			TestName:    "Nil",
+			Credentials: nil,
+			Expected:    []string{},
+		},
+		{
+			TestName: "VerifyAndSign",
+			Credentials: &[]stable.KeyCredential{
+				keyCredential("a", KeyCredentialUsageVerify, "2026-01-01T00:00:00Z"),
+				keyCredential("b", KeyCredentialUsageSign, "2026-01-01T00:00:00Z"),
+				keyCredential("c", KeyCredentialUsageVerify, "2027-01-01T00:00:00Z"),
+			},
+			Expected: []string{"a", "b"},
+		},
+		{
+			TestName: "NoEndDate",
+			Credentials: &[]stable.KeyCredential{
+				keyCredential("a", KeyCredentialUsageVerify, ""),
+			},
+			Expected: []string{},
+		},
+		{
+			TestName: "InvalidEndDate",
+			Credentials: &[]stable.KeyCredential{
+				keyCredential("a", KeyCredentialUsageVerify, "2026-01-01"),
+			},
+			ExpectError: "end_date",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			result, err := ExpiringKeyCredentials(tc.Credentials, cutoff)
+			checkExpiringCredentials(t, result, err, tc.Expected, tc.ExpectError)
+		})
+	}
+}
+
+func TestExpiringCredentialExpired(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		TestName    string
+		EndDateTime time.Time
+		Expected    bool
+	}{
+		{
+			TestName:    "Past",
+			EndDateTime: now.Add(-time.Second),
+			Expected:    true,
+		},
+		{
+			TestName:    "Now",
+			EndDateTime: now,
+			Expected:    true,
+		},
+		{
+			TestName:    "Future",
+			EndDateTime: now.Add(time.Second),
+			Expected:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			if actual := (ExpiringCredential{EndDateTime: tc.EndDateTime}).Expired(now); actual != tc.Expected {
+				t.Fatalf("expected %t, got %t", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func checkExpiringCredentials(t *testing.T, result []ExpiringCredential, err error, expected []string, expectError string) {
+	t.Helper()
+
+	if expectError != "" {
+		var credentialError CredentialError
+		if !errors.As(err, &credentialError) {
+			t.Fatalf("expected a CredentialError, got %v", err)
+		}
+		if credentialError.Attr() != expectError {
+			t.Fatalf("expected error for %q, got %q", expectError, credentialError.Attr())
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if len(result) != len(expected) {
+		t.Fatalf("expected %d credentials, got %d: %+v", len(expected), len(result), result)
+	}
+	for i, keyId := range expected {
+		if result[i].KeyId != keyId {
+			t.Fatalf("expected credential %d to have key ID %q, got %q", i, keyId, result[i].KeyId)
+		}
+		if result[i].EndDateTime.IsZero() {
+			t.Fatalf("expected credential %q to have an end date", keyId)
+		}
+	}
+}
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package customsecurityattributes reads and assigns the custom security attributes of users and service principals.
+// The SDK models these as an opaque type, since the shape of the JSON depends on the attribute definitions in the
+// tenant, so they are read and written here as raw JSON.
+package customsecurityattributes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+const (
+	TypeBoolean = "Boolean"
+	TypeInteger = "Integer"
+	TypeString  = "String"
+)
+
+const valueODataType = "#Microsoft.DirectoryServices.CustomSecurityAttributeValue"
+
+// Schema returns the schema for the `custom_security_attributes` block of users and service principals
+func Schema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: "Custom security attributes assigned to the principal. Only attributes specified here are managed",
+		Type:        pluginsdk.TypeSet,
+		Optional:    true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"attribute_set": {
+					Description:  "The name of the attribute set in which the attribute is defined",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"name": {
+					Description:  "The name of the custom security attribute",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"type": {
+					Description:  "The data type of the attribute. Must be one of `Boolean`, `Integer` or `String`, matching the attribute definition",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{TypeBoolean, TypeInteger, TypeString}, false),
+				},
+
+				"value": {
+					Description: "The value of a single-valued attribute. Boolean values must be `true` or `false`",
+					Type:        pluginsdk.TypeString,
+					Optional:    true,
+				},
+
+				"values": {
+					Description: "The values of an attribute that allows multiple values",
+					Type:        pluginsdk.TypeSet,
+					Optional:    true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Expand builds the `customSecurityAttributes` payload for the attributes in `input`. Attributes which are present in
+// `previous` but not in `input` are set to null, which unassigns them from the principal.
+func Expand(input, previous []interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+
+	attributeSet := func(name string) map[string]interface{} {
+		if set, ok := result[name]; ok {
+			return set.(map[string]interface{})
+		}
+		set := map[string]interface{}{
+			"@odata.type": valueODataType,
+		}
+		result[name] = set
+		return set
+	}
+
+	for _, p := range previous {
+		if p == nil {
+			continue
+		}
+		attr := p.(map[string]interface{})
+		attributeSet(attr["attribute_set"].(string))[attr["name"].(string)] = nil
+	}
+
+	for _, i := range input {
+		if i == nil {
+			continue
+		}
+		attr := i.(map[string]interface{})
+		setName := attr["attribute_set"].(string)
+		name := attr["name"].(string)
+		attrType := attr["type"].(string)
+		value := attr["value"].(string)
+
+		var values []string
+		if v, ok := attr["values"].(*pluginsdk.Set); ok && v != nil {
+			for _, s := range v.List() {
+				values = append(values, s.(string))
+			}
+		}
+		sort.Strings(values)
+
+		if value != "" && len(values) > 0 {
+			return nil, fmt.Errorf("only one of `value` or `values` can be specified for custom security attribute %q in attribute set %q", name, setName)
+		}
+		if value == "" && len(values) == 0 {
+			return nil, fmt.Errorf("one of `value` or `values` must be specified for custom security attribute %q in attribute set %q", name, setName)
+		}
+
+		set := attributeSet(setName)
+		delete(set, name+"@odata.type")
+
+		switch attrType {
+		case TypeBoolean:
+			if len(values) > 0 {
+				return nil, fmt.Errorf("custom security attribute %q in attribute set %q is of type %q and cannot have multiple `values`", name, setName, attrType)
+			}
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("parsing value %q for boolean custom security attribute %q in attribute set %q: %+v", value, name, setName, err)
+			}
+			set[name] = b
+
+		case TypeInteger:
+			if len(values) > 0 {
+				ints := make([]int32, 0, len(values))
+				for _, s := range values {
+					n, err := strconv.ParseInt(s, 10, 32)
+					if err != nil {
+						return nil, fmt.Errorf("parsing value %q for integer custom security attribute %q in attribute set %q: %+v", s, name, setName, err)
+					}
+					ints = append(ints, int32(n))
+				}
+				set[name+"@odata.type"] = "#Collection(Int32)"
+				set[name] = ints
+			} else {
+				n, err := strconv.ParseInt(value, 10, 32)
+				if err != nil {
+					return nil, fmt.Errorf("parsing value %q for integer custom security attribute %q in attribute set %q: %+v", value, name, setName, err)
+				}
+				set[name+"@odata.type"] = "#Int32"
+				set[name] = int32(n)
+			}
+
+		case TypeString:
+			if len(values) > 0 {
+				set[name+"@odata.type"] = "#Collection(String)"
+				set[name] = values
+			} else {
+				set[name] = value
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Flatten converts the `customSecurityAttributes` returned by the API into the `custom_security_attributes` block.
+// Only attributes which are present in `managed`, matched by attribute set and name, are returned, so that attributes
+// assigned outside of Terraform are not subsequently unassigned.
+func Flatten(input map[string]map[string]json.RawMessage, managed []interface{}) []interface{} {
+	result := make([]interface{}, 0)
+
+	managedNames := make(map[string]bool)
+	for _, m := range managed {
+		if m == nil {
+			continue
+		}
+		attr := m.(map[string]interface{})
+		managedNames[managedKey(attr["attribute_set"].(string), attr["name"].(string))] = true
+	}
+
+	setNames := make([]string, 0, len(input))
+	for setName := range input {
+		setNames = append(setNames, setName)
+	}
+	sort.Strings(setNames)
+
+	for _, setName := range setNames {
+		set := input[setName]
+
+		names := make([]string, 0, len(set))
+		for name := range set {
+			if !strings.Contains(name, "@") {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if !managedNames[managedKey(setName, name)] {
+				continue
+			}
+
+			var odataType string
+			if v, ok := set[name+"@odata.type"]; ok {
+				_ = json.Unmarshal(v, &odataType)
+			}
+
+			var raw interface{}
+			if err := json.Unmarshal(set[name], &raw); err != nil || raw == nil {
+				continue
+			}
+
+			attr := map[string]interface{}{
+				"attribute_set": setName,
+				"name":          name,
+				"value":         "",
+				"values":        []interface{}{},
+			}
+
+			switch v := raw.(type) {
+			case bool:
+				attr["type"] = TypeBoolean
+				attr["value"] = strconv.FormatBool(v)
+			case float64:
+				attr["type"] = TypeInteger
+				attr["value"] = strconv.FormatInt(int64(v), 10)
+			case string:
+				attr["type"] = TypeString
+				attr["value"] = v
+			case []interface{}:
+				attr["type"] = TypeString
+				if strings.EqualFold(odataType, "#Collection(Int32)") {
+					attr["type"] = TypeInteger
+				}
+				values := make([]interface{}, 0, len(v))
+				for _, item := range v {
+					switch i := item.(type) {
+					case float64:
+						attr["type"] = TypeInteger
+						values = append(values, strconv.FormatInt(int64(i), 10))
+					case string:
+						values = append(values, i)
+					}
+				}
+				attr["values"] = values
+			default:
+				continue
+			}
+
+			result = append(result, attr)
+		}
+	}
+
+	return result
+}
+
+// managedKey returns a key identifying an attribute, since attribute set and attribute names are case-insensitive
+func managedKey(setName, name string) string {
+	return strings.ToLower(setName) + "/" + strings.ToLower(name)
+}
+
+type getOptions struct{}
+
+func (o getOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o getOptions) ToOData() *odata.Query {
+	return &odata.Query{
+		Select: []string{"customSecurityAttributes"},
+	}
+}
+
+func (o getOptions) ToQuery() *client.QueryParams {
+	return &client.QueryParams{}
+}
+
+// Get retrieves the custom security attributes assigned to the user or service principal with the given ID. This
+// requires the CustomSecAttributeAssignment.Read.All permission.
+func Get(ctx context.Context, c *msgraph.Client, id resourceids.ResourceId) (*http.Response, map[string]map[string]json.RawMessage, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: getOptions{},
+		Path:          id.ID(),
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp == nil {
+		return nil, nil, err
+	}
+	if err != nil {
+		return resp.Response, nil, err
+	}
+
+	var model struct {
+		CustomSecurityAttributes map[string]map[string]json.RawMessage `json:"customSecurityAttributes"`
+	}
+	if err = resp.Unmarshal(&model); err != nil {
+		return resp.Response, nil, err
+	}
+
+	return resp.Response, model.CustomSecurityAttributes, nil
+}
+
+// Update assigns the custom security attributes in the given payload, as built by Expand, to the user or service
+// principal with the given ID. This requires the CustomSecAttributeAssignment.ReadWrite.All permission.
+func Update(ctx context.Context, c *msgraph.Client, id resourceids.ResourceId, attributes map[string]interface{}) (*http.Response, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPatch,
+		Path:       id.ID(),
+
+		// The principal may have only just been created, so retry until it has replicated
+		RetryFunc: func(resp *http.Response, o *odata.OData) (bool, error) {
+			return response.WasNotFound(resp), nil
+		},
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = req.Marshal(map[string]interface{}{"customSecurityAttributes": attributes}); err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp == nil {
+		return nil, err
+	}
+
+	return resp.Response, err
+}
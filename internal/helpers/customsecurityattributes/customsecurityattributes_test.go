@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customsecurityattributes
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func testAttribute(attributeSet, name, attrType, value string, values ...string) map[string]interface{} {
+	items := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		items = append(items, v)
+	}
+
+	return map[string]interface{}{
+		"attribute_set": attributeSet,
+		"name":          name,
+		"type":          attrType,
+		"value":         value,
+		"values":        pluginsdk.NewSet(pluginsdk.HashString, items),
+	}
+}
+
+func TestExpand(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    []interface{}
+		previous []interface{}
+		expected string
+		error    bool
+	}{
+		{
+			name: "all types",
+			input: []interface{}{
+				testAttribute("Engineering", "Certified", TypeBoolean, "true"),
+				testAttribute("Engineering", "Level", TypeInteger, "3"),
+				testAttribute("Engineering", "Project", TypeString, "", "Cascade", "Baker"),
+				testAttribute("Marketing", "Region", TypeString, "EMEA"),
+				testAttribute("Marketing", "Ids", TypeInteger, "", "2", "1"),
+			},
+			expected: `{
+				"Engineering": {
+					"@odata.type": "#Microsoft.DirectoryServices.CustomSecurityAttributeValue",
+					"Certified": true,
+					"Level@odata.type": "#Int32",
+					"Level": 3,
+					"Project@odata.type": "#Collection(String)",
+					"Project": ["Baker", "Cascade"]
+				},
+				"Marketing": {
+					"@odata.type": "#Microsoft.DirectoryServices.CustomSecurityAttributeValue",
+					"Ids@odata.type": "#Collection(Int32)",
+					"Ids": [1, 2],
+					"Region": "EMEA"
+				}
+			}`,
+		},
+		{
+			name: "removed attributes are nulled",
+			input: []interface{}{
+				testAttribute("Engineering", "Level", TypeInteger, "4"),
+			},
+			previous: []interface{}{
+				testAttribute("Engineering", "Level", TypeInteger, "3"),
+				testAttribute("Engineering", "Project", TypeString, "Baker"),
+				testAttribute("Marketing", "Region", TypeString, "EMEA"),
+			},
+			expected: `{
+				"Engineering": {
+					"@odata.type": "#Microsoft.DirectoryServices.CustomSecurityAttributeValue",
+					"Level@odata.type": "#Int32",
+					"Level": 4,
+					"Project": null
+				},
+				"Marketing": {
+					"@odata.type": "#Microsoft.DirectoryServices.CustomSecurityAttributeValue",
+					"Region": null
+				}
+			}`,
+		},
+		{
+			name:  "missing value",
+			input: []interface{}{testAttribute("Engineering", "Project", TypeString, "")},
+			error: true,
+		},
+		{
+			name:  "value and values",
+			input: []interface{}{testAttribute("Engineering", "Project", TypeString, "Baker", "Cascade")},
+			error: true,
+		},
+		{
+			name:  "boolean collection",
+			input: []interface{}{testAttribute("Engineering", "Certified", TypeBoolean, "", "true")},
+			error: true,
+		},
+		{
+			name:  "invalid integer",
+			input: []interface{}{testAttribute("Engineering", "Level", TypeInteger, "three")},
+			error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := Expand(tc.input, tc.previous)
+			if tc.error {
+				if err == nil {
+					t.Fatal("expected an error but none was returned")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			// Compare the serialized payloads, since that is what is sent to the API
+			actualJson, err := json.Marshal(result)
+			if err != nil {
+				t.Fatalf("marshalling result: %+v", err)
+			}
+
+			var actual, expected interface{}
+			if err = json.Unmarshal(actualJson, &actual); err != nil {
+				t.Fatalf("unmarshalling result: %+v", err)
+			}
+			if err = json.Unmarshal([]byte(tc.expected), &expected); err != nil {
+				t.Fatalf("unmarshalling expected result: %+v", err)
+			}
+
+			if !reflect.DeepEqual(actual, expected) {
+				t.Fatalf("unexpected result\nexpected: %s\nactual:   %s", tc.expected, actualJson)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	var input map[string]map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(`{
+		"Engineering": {
+			"@odata.type": "#microsoft.graph.customSecurityAttributeValue",
+			"Certified": false,
+			"Level@odata.type": "#Int32",
+			"Level": 3,
+			"Project@odata.type": "#Collection(String)",
+			"Project": ["Baker", "Cascade"]
+		},
+		"Marketing": {
+			"@odata.type": "#microsoft.graph.customSecurityAttributeValue",
+			"Ids@odata.type": "#Collection(Int32)",
+			"Ids": [1, 2],
+			"Region": "EMEA"
+		}
+	}`), &input); err != nil {
+		t.Fatalf("unmarshalling input: %+v", err)
+	}
+
+	managed := []interface{}{
+		testAttribute("Engineering", "Certified", TypeBoolean, "true"),
+		testAttribute("Engineering", "Level", TypeInteger, "3"),
+		testAttribute("Engineering", "Project", TypeString, "", "Baker"),
+		testAttribute("marketing", "ids", TypeInteger, "", "1", "2"),
+	}
+
+	// Marketing/Region is not managed, so it should be omitted
+	expected := []interface{}{
+		map[string]interface{}{"attribute_set": "Engineering", "name": "Certified", "type": TypeBoolean, "value": "false", "values": []interface{}{}},
+		map[string]interface{}{"attribute_set": "Engineering", "name": "Level", "type": TypeInteger, "value": "3", "values": []interface{}{}},
+		map[string]interface{}{"attribute_set": "Engineering", "name": "Project", "type": TypeString, "value": "", "values": []interface{}{"Baker", "Cascade"}},
+		map[string]interface{}{"attribute_set": "Marketing", "name": "Ids", "type": TypeInteger, "value": "", "values": []interface{}{"1", "2"}},
+	}
+
+	if actual := Flatten(input, managed); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("unexpected result\nexpected: %+v\nactual:   %+v", expected, actual)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package graphrequest provides the shared request handling for the small number of Microsoft Graph endpoints which
+// are not yet covered by the SDK, so that the service clients wrapping them only need to describe each operation.
+package graphrequest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// Options configures a single request
+type Options struct {
+	RetryFunc client.RequestRetryFunc
+}
+
+// Pager follows the `@odata.nextLink` returned with each page of a list response
+type Pager struct {
+	NextLink *odata.Link `json:"@odata.nextLink"`
+}
+
+func (p *Pager) NextPageLink() *odata.Link {
+	defer func() {
+		p.NextLink = nil
+	}()
+
+	return p.NextLink
+}
+
+// Execute sends a request to the API, marshalling the input as the request body when it is not nil. When paged is
+// true, all pages of a list response are retrieved and their values combined into the returned response.
+func Execute(ctx context.Context, c *msgraph.Client, method, path string, input interface{}, paged bool, options Options) (*client.Response, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: expectedStatusCodes(method),
+		HttpMethod:          method,
+		Path:                path,
+		RetryFunc:           options.RetryFunc,
+	}
+
+	if paged {
+		opts.Pager = &Pager{}
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if input != nil {
+		if err = req.Marshal(input); err != nil {
+			return nil, err
+		}
+	}
+
+	if paged {
+		return req.ExecutePaged(ctx)
+	}
+
+	return req.Execute(ctx)
+}
+
+func expectedStatusCodes(method string) []int {
+	switch method {
+	case http.MethodPost:
+		return []int{http.StatusCreated, http.StatusOK, http.StatusNoContent}
+	case http.MethodPatch:
+		return []int{http.StatusNoContent, http.StatusOK, http.StatusAccepted}
+	case http.MethodPut:
+		return []int{http.StatusCreated, http.StatusNoContent, http.StatusOK}
+	case http.MethodDelete:
+		return []int{http.StatusNoContent, http.StatusOK}
+	}
+
+	return []int{http.StatusOK}
+}
+
+// UnmarshalValues decodes the `value` array of a list response
+func UnmarshalValues[T any](resp *client.Response) (*[]T, error) {
+	var values struct {
+		Values *[]T `json:"value"`
+	}
+	if err := resp.Unmarshal(&values); err != nil {
+		return nil, err
+	}
+
+	return values.Values, nil
+}
+
+// UnmarshalValueImplementations decodes the `value` array of a list response containing a discriminated type, such
+// as stable.DirectoryObject, using the provided SDK unmarshal function for each item
+func UnmarshalValueImplementations[T any](resp *client.Response, unmarshal func([]byte) (T, error)) (*[]T, error) {
+	values, err := UnmarshalValues[json.RawMessage](resp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]T, 0)
+	if values != nil {
+		for i, v := range *values {
+			val, err := unmarshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("unmarshalling item %d (%q): %+v", i, v, err)
+			}
+			result = append(result, val)
+		}
+	}
+
+	return &result, nil
+}
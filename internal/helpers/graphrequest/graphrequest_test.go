@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package graphrequest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+func testClient(t *testing.T, handler http.HandlerFunc) *msgraph.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := msgraph.NewClient(environments.MicrosoftGraphAPI(server.URL), "test", msgraph.VersionOnePointZero)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	return c
+}
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestExecutePaged(t *testing.T) {
+	var serverUrl string
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"value":[{"id":"c"}]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"value":[{"id":"a"},{"id":"b"}],"@odata.nextLink":"%s/v1.0/things?page=2"}`, serverUrl)
+	})
+	serverUrl = c.BaseUri[:len(c.BaseUri)-len("/v1.0")]
+
+	resp, err := Execute(testContext(t), c, http.MethodGet, "/things", nil, true, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	type thing struct {
+		Id string `json:"id"`
+	}
+	values, err := UnmarshalValues[thing](resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if values == nil || len(*values) != 3 || (*values)[2].Id != "c" {
+		t.Fatalf("expected 3 values from both pages, got %+v", values)
+	}
+}
+
+func TestExecuteInput(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["name"] != "test" {
+			t.Errorf("unexpected request body: %+v (%v)", body, err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := Execute(testContext(t), c, http.MethodPost, "/things/$ref", map[string]string{"name": "test"}, false, Options{}); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+func TestExecuteUnexpectedStatus(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	resp, err := Execute(testContext(t), c, http.MethodGet, "/things", nil, false, Options{})
+	if err == nil {
+		t.Fatal("expected an error for an unexpected status code")
+	}
+	if resp == nil || resp.Response == nil || resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected the response to be returned with the error, got %+v", resp)
+	}
+}
+
+func TestUnmarshalValueImplementations(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"value":[{"kind":"a"},{"kind":"b"},{"kind":"x"}]}`)
+	})
+
+	resp, err := Execute(testContext(t), c, http.MethodGet, "/things", nil, true, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	unmarshal := func(input []byte) (string, error) {
+		var v struct {
+			Kind string `json:"kind"`
+		}
+		if err := json.Unmarshal(input, &v); err != nil {
+			return "", err
+		}
+		if v.Kind == "x" {
+			return "", fmt.Errorf("unknown kind %q", v.Kind)
+		}
+		return v.Kind, nil
+	}
+
+	if _, err = UnmarshalValueImplementations(resp, unmarshal); err == nil {
+		t.Fatal("expected an error for an unknown implementation")
+	}
+}
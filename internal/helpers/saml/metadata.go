@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package saml
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// maxMetadataSize limits how much of the response body is read when fetching federation metadata
+const maxMetadataSize = 10 * 1024 * 1024
+
+// defaultFetchTimeout is used when fetching federation metadata without a deadline on the context
+const defaultFetchTimeout = 5 * time.Minute
+
+// Metadata is the subset of a SAML 2.0 identity provider metadata document that is useful for configuring a service
+// provider
+type Metadata struct {
+	EntityId            string
+	SingleSignOnService []Endpoint
+	SingleLogoutService []Endpoint
+	SigningCertificates []Certificate
+}
+
+type Endpoint struct {
+	Binding  string
+	Location string
+}
+
+type Certificate struct {
+	// Value is the base64-encoded DER certificate, as it appears in the metadata document
+	Value      string
+	Thumbprint string
+	NotBefore  time.Time
+	NotAfter   time.Time
+	Subject    string
+}
+
+// FederationMetadataUrl returns the URL of the application-specific federation metadata document for a tenant
+func FederationMetadataUrl(loginEndpoint, tenantId, clientId string) string {
+	u := fmt.Sprintf("%s/%s/federationmetadata/2007-06/federationmetadata.xml", strings.TrimSuffix(loginEndpoint, "/"), url.PathEscape(tenantId))
+	if clientId != "" {
+		u = fmt.Sprintf("%s?appid=%s", u, url.QueryEscape(clientId))
+	}
+	return u
+}
+
+// FetchMetadata retrieves the raw metadata document from the specified URL. Transient failures are retried, and the
+// request is bounded by the deadline of the context, so that a slow endpoint cannot block indefinitely.
+func FetchMetadata(ctx context.Context, metadataUrl, userAgent string) ([]byte, error) {
+	timeout := defaultFetchTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	client := retryablehttp.NewClient()
+	client.HTTPClient.Timeout = timeout
+	client.Logger = nil
+	client.RetryMax = 3
+
+	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodGet, metadataUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+	req.Header.Set("Accept", "application/samlmetadata+xml, application/xml, text/xml")
+	if userAgent != "" {
+		req.Header.Set("User-Agent", userAgent)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %q: %+v", metadataUrl, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("retrieving %q: unexpected status %d", metadataUrl, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMetadataSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading response from %q: %+v", metadataUrl, err)
+	}
+
+	return body, nil
+}
+
+type entityDescriptor struct {
+	XMLName          xml.Name           `xml:"EntityDescriptor"`
+	EntityId         string             `xml:"entityID,attr"`
+	IdpSsoDescriptor []idpSsoDescriptor `xml:"IDPSSODescriptor"`
+}
+
+type idpSsoDescriptor struct {
+	KeyDescriptors      []keyDescriptor `xml:"KeyDescriptor"`
+	SingleLogoutService []endpoint      `xml:"SingleLogoutService"`
+	SingleSignOnService []endpoint      `xml:"SingleSignOnService"`
+}
+
+type keyDescriptor struct {
+	Use              string   `xml:"use,attr"`
+	X509Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+type endpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// ParseMetadata parses a SAML 2.0 metadata document and extracts the identity provider details. Certificates are
+// validated and their thumbprints computed, so that an invalid document is reported rather than silently accepted.
+func ParseMetadata(data []byte) (*Metadata, error) {
+	var doc entityDescriptor
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing metadata document: %+v", err)
+	}
+
+	if doc.EntityId == "" {
+		return nil, errors.New("metadata document does not specify an entityID")
+	}
+	if len(doc.IdpSsoDescriptor) == 0 {
+		return nil, errors.New("metadata document does not contain an IDPSSODescriptor")
+	}
+
+	result := Metadata{
+		EntityId:            doc.EntityId,
+		SingleSignOnService: make([]Endpoint, 0),
+		SingleLogoutService: make([]Endpoint, 0),
+		SigningCertificates: make([]Certificate, 0),
+	}
+
+	seen := make(map[string]bool)
+	for _, descriptor := range doc.IdpSsoDescriptor {
+		for _, e := range descriptor.SingleSignOnService {
+			result.SingleSignOnService = append(result.SingleSignOnService, Endpoint(e))
+		}
+		for _, e := range descriptor.SingleLogoutService {
+			result.SingleLogoutService = append(result.SingleLogoutService, Endpoint(e))
+		}
+
+		for _, key := range descriptor.KeyDescriptors {
+			// A key descriptor without a `use` attribute applies to both signing and encryption
+			if key.Use != "" && key.Use != "signing" {
+				continue
+			}
+
+			for _, raw := range key.X509Certificates {
+				certificate, err := parseCertificate(raw)
+				if err != nil {
+					return nil, err
+				}
+				if seen[certificate.Thumbprint] {
+					continue
+				}
+				seen[certificate.Thumbprint] = true
+				result.SigningCertificates = append(result.SigningCertificates, *certificate)
+			}
+		}
+	}
+
+	if len(result.SingleSignOnService) == 0 {
+		return nil, errors.New("metadata document does not contain any SingleSignOnService endpoints")
+	}
+
+	return &result, nil
+}
+
+func parseCertificate(raw string) (*Certificate, error) {
+	// Certificates are often wrapped across multiple lines in metadata documents
+	value := strings.Join(strings.Fields(raw), "")
+
+	der, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("decoding signing certificate: %+v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing signing certificate: %+v", err)
+	}
+
+	thumbprint := sha1.Sum(cert.Raw)
+
+	return &Certificate{
+		Value:      value,
+		Thumbprint: strings.ToUpper(fmt.Sprintf("%x", thumbprint)),
+		NotBefore:  cert.NotBefore.UTC(),
+		NotAfter:   cert.NotAfter.UTC(),
+		Subject:    cert.Subject.String(),
+	}, nil
+}
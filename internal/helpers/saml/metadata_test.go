@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package saml
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testCertificate(t *testing.T, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %+v", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Microsoft Azure Federated SSO Certificate"},
+		NotBefore:    notAfter.AddDate(-3, 0, 0),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %+v", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+func testMetadata(certificate string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="utf-8"?>
+<EntityDescriptor ID="_00000000-0000-0000-0000-000000000000" entityID="https://sts.windows.net/00000000-0000-0000-0000-000000000000/" xmlns="urn:oasis:names:tc:SAML:2.0:metadata">
+  <RoleDescriptor xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="fed:SecurityTokenServiceType" protocolSupportEnumeration="http://docs.oasis-open.org/wsfed/federation/200706" xmlns:fed="http://docs.oasis-open.org/wsfed/federation/200706">
+    <KeyDescriptor use="signing">
+      <KeyInfo xmlns="http://www.w3.org/2000/09/xmldsig#">
+        <X509Data>
+          <X509Certificate>%[1]s</X509Certificate>
+        </X509Data>
+      </KeyInfo>
+    </KeyDescriptor>
+  </RoleDescriptor>
+  <IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <KeyDescriptor use="signing">
+      <KeyInfo xmlns="http://www.w3.org/2000/09/xmldsig#">
+        <X509Data>
+          <X509Certificate>
+            %[1]s
+          </X509Certificate>
+        </X509Data>
+      </KeyInfo>
+    </KeyDescriptor>
+    <SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000/saml2" />
+    <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000/saml2" />
+    <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000/saml2" />
+  </IDPSSODescriptor>
+</EntityDescriptor>
+`, certificate)
+}
+
+func TestParseMetadata(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	certificate := testCertificate(t, notAfter)
+
+	metadata, err := ParseMetadata([]byte(testMetadata(certificate)))
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if expected := "https://sts.windows.net/00000000-0000-0000-0000-000000000000/"; metadata.EntityId != expected {
+		t.Fatalf("expected entity ID %q, got %q", expected, metadata.EntityId)
+	}
+
+	if len(metadata.SingleSignOnService) != 2 {
+		t.Fatalf("expected 2 single sign-on endpoints, got %d", len(metadata.SingleSignOnService))
+	}
+	if expected := "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"; metadata.SingleSignOnService[1].Binding != expected {
+		t.Fatalf("expected binding %q, got %q", expected, metadata.SingleSignOnService[1].Binding)
+	}
+
+	if len(metadata.SingleLogoutService) != 1 {
+		t.Fatalf("expected 1 single logout endpoint, got %d", len(metadata.SingleLogoutService))
+	}
+
+	if len(metadata.SigningCertificates) != 1 {
+		t.Fatalf("expected 1 signing certificate, got %d", len(metadata.SigningCertificates))
+	}
+	cert := metadata.SigningCertificates[0]
+	if cert.Value != certificate {
+		t.Fatalf("expected certificate value to have whitespace removed")
+	}
+	if len(cert.Thumbprint) != 40 || strings.ToUpper(cert.Thumbprint) != cert.Thumbprint {
+		t.Fatalf("expected an uppercase hex SHA-1 thumbprint, got %q", cert.Thumbprint)
+	}
+	if !cert.NotAfter.Equal(notAfter) {
+		t.Fatalf("expected expiry %s, got %s", notAfter, cert.NotAfter)
+	}
+}
+
+func TestParseMetadataInvalid(t *testing.T) {
+	cases := map[string]string{
+		"NotXml":             "not xml",
+		"NoEntityId":         `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata"><IDPSSODescriptor /></EntityDescriptor>`,
+		"NoIdpSsoDescriptor": `<EntityDescriptor entityID="https://example.com" xmlns="urn:oasis:names:tc:SAML:2.0:metadata" />`,
+		"NoEndpoints":        `<EntityDescriptor entityID="https://example.com" xmlns="urn:oasis:names:tc:SAML:2.0:metadata"><IDPSSODescriptor /></EntityDescriptor>`,
+		"InvalidCertificate": testMetadata("bm90IGEgY2VydGlmaWNhdGU="),
+	}
+
+	for name, input := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseMetadata([]byte(input)); err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+		})
+	}
+}
+
+func TestFederationMetadataUrl(t *testing.T) {
+	expected := "https://login.microsoftonline.us/00000000-0000-0000-0000-000000000000/federationmetadata/2007-06/federationmetadata.xml?appid=11111111-1111-1111-1111-111111111111"
+	if actual := FederationMetadataUrl("https://login.microsoftonline.us/", "00000000-0000-0000-0000-000000000000", "11111111-1111-1111-1111-111111111111"); actual != expected {
+		t.Fatalf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestFetchMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ua := r.Header.Get("User-Agent"); ua != "terraform-provider-azuread/test" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, "<EntityDescriptor />")
+	}))
+	defer server.Close()
+
+	body, err := FetchMetadata(context.Background(), server.URL, "terraform-provider-azuread/test")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if string(body) != "<EntityDescriptor />" {
+		t.Fatalf("unexpected body %q", body)
+	}
+}
+
+func TestFetchMetadataTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := FetchMetadata(ctx, server.URL, ""); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("expected the request to be bounded by the context deadline, took %s", elapsed)
+	}
+}
@@ -0,0 +1,600 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+const (
+	DynamicMembershipRuleMemberTypeDevice = "device"
+	DynamicMembershipRuleMemberTypeUser   = "user"
+)
+
+// DynamicMembershipRuleError describes a problem with a dynamic membership rule, along with the 1-based character
+// position at which it was found
+type DynamicMembershipRuleError struct {
+	Position int
+	Message  string
+
+	// Unrecognised is true when the rule uses syntax that is not known to the parser, such as an unknown operator or a
+	// newer function, in which case the rule may still be valid and should be left for the API to validate
+	Unrecognised bool
+}
+
+func (e DynamicMembershipRuleError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Position)
+}
+
+// DynamicMembershipRuleInfo describes a dynamic membership rule that was parsed successfully
+type DynamicMembershipRuleInfo struct {
+	// DirectReports is true when the rule is a "Direct Reports" rule for a manager
+	DirectReports bool
+
+	// MemberOf is true when the rule selects members of other groups
+	MemberOf bool
+
+	// MemberType is the type of member selected by the rule, either `user` or `device`
+	MemberType string
+
+	// Properties contains the fully qualified properties referenced by the rule, in order of appearance
+	Properties []string
+}
+
+var (
+	dynamicMembershipRuleDirectReportsRegex   = regexp.MustCompile(`(?i)^\s*direct\s+reports\s+for\s+"([^"]*)"\s*$`)
+	dynamicMembershipRuleExtensionAttrRegex   = regexp.MustCompile(`(?i)^extensionAttribute([1-9]|1[0-5])$`)
+	dynamicMembershipRuleCustomExtensionRegex = regexp.MustCompile(`(?i)^extension_[0-9a-f]{32}_\w+$`)
+	dynamicMembershipRuleDurationRegex        = regexp.MustCompile(`(?i)^p(\d+y)?(\d+m)?(\d+w)?(\d+d)?(t(\d+h)?(\d+m)?(\d+(\.\d+)?s)?)?$`)
+)
+
+// dynamicMembershipRuleProperties lists the supported properties for each member type. The value indicates whether the
+// property is multivalued, in which case it must be used with the -any or -all operators.
+var dynamicMembershipRuleProperties = map[string]map[string]bool{
+	DynamicMembershipRuleMemberTypeUser: {
+		"accountenabled":               false,
+		"assignedplans":                true,
+		"city":                         false,
+		"companyname":                  false,
+		"country":                      false,
+		"department":                   false,
+		"dirsyncenabled":               false,
+		"displayname":                  false,
+		"employeehiredate":             false,
+		"employeeid":                   false,
+		"employeeorgdata.costcenter":   false,
+		"employeeorgdata.division":     false,
+		"employeetype":                 false,
+		"facsimiletelephonenumber":     false,
+		"givenname":                    false,
+		"jobtitle":                     false,
+		"mail":                         false,
+		"mailnickname":                 false,
+		"memberof":                     true,
+		"mobile":                       false,
+		"objectid":                     false,
+		"onpremisesdistinguishedname":  false,
+		"onpremisessamaccountname":     false,
+		"onpremisessecurityidentifier": false,
+		"othermails":                   true,
+		"passwordpolicies":             false,
+		"physicaldeliveryofficename":   false,
+		"postalcode":                   false,
+		"preferredlanguage":            false,
+		"proxyaddresses":               true,
+		"sipproxyaddress":              false,
+		"state":                        false,
+		"streetaddress":                false,
+		"surname":                      false,
+		"telephonenumber":              false,
+		"usagelocation":                false,
+		"userprincipalname":            false,
+		"usertype":                     false,
+	},
+	DynamicMembershipRuleMemberTypeDevice: {
+		"accountenabled":        false,
+		"devicecategory":        false,
+		"deviceid":              false,
+		"devicemanagementappid": false,
+		"devicemanufacturer":    false,
+		"devicemodel":           false,
+		"deviceostype":          false,
+		"deviceosversion":       false,
+		"deviceownership":       false,
+		"devicephysicalids":     true,
+		"devicetrusttype":       false,
+		"displayname":           false,
+		"enrollmentprofilename": false,
+		"isrooted":              false,
+		"managementtype":        false,
+		"memberof":              true,
+		"objectid":              false,
+		"organizationalunit":    false,
+		"profiletype":           false,
+		"systemlabels":          true,
+	},
+}
+
+// dynamicMembershipRuleCollectionProperties lists the properties that may be used inside the expression following -any
+// or -all, for each multivalued property. An empty value indicates a collection of strings, referenced with `_`.
+var dynamicMembershipRuleCollectionProperties = map[string]struct {
+	prefix     string
+	properties []string
+}{
+	"assignedplans": {prefix: "assignedPlan", properties: []string{"capabilityStatus", "service", "servicePlanId"}},
+	"memberof":      {prefix: "group", properties: []string{"objectId"}},
+}
+
+var dynamicMembershipRuleOperators = map[string]bool{
+	"all":           true,
+	"any":           true,
+	"contains":      true,
+	"eq":            true,
+	"ge":            true,
+	"in":            true,
+	"le":            true,
+	"match":         true,
+	"ne":            true,
+	"notcontains":   true,
+	"notin":         true,
+	"notmatch":      true,
+	"notstartswith": true,
+	"startswith":    true,
+}
+
+// DynamicMembershipRule validates the syntax of a dynamic membership rule, and that the properties it references are
+// valid for a single member type. Rules containing syntax that is not recognised produce a warning instead of an error.
+func DynamicMembershipRule(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	// An empty rule is permitted, since it is meaningful when dynamic membership processing is paused
+	if strings.TrimSpace(v) == "" {
+		return nil, nil
+	}
+
+	// The rule syntax evolves independently of this provider, so a rule using unrecognised syntax is left for the API
+	// to validate rather than preventing an otherwise valid configuration from being applied
+	if _, err := ParseDynamicMembershipRule(v, ""); err != nil {
+		var ruleErr DynamicMembershipRuleError
+		if errors.As(err, &ruleErr) && ruleErr.Unrecognised {
+			return []string{fmt.Sprintf("could not validate the dynamic membership rule for %q, it will be validated by the API when applied: %v", k, err)}, nil
+		}
+		return nil, []error{fmt.Errorf("invalid dynamic membership rule for %q: %v", k, err)}
+	}
+
+	return nil, nil
+}
+
+// ParseDynamicMembershipRule parses a dynamic membership rule, returning a DynamicMembershipRuleError describing the
+// first problem found. When memberType is specified, all properties referenced in the rule must be valid for that
+// member type, otherwise the member type is inferred from the first property referenced.
+func ParseDynamicMembershipRule(rule, memberType string) (*DynamicMembershipRuleInfo, error) {
+	if memberType != "" && memberType != DynamicMembershipRuleMemberTypeUser && memberType != DynamicMembershipRuleMemberTypeDevice {
+		return nil, fmt.Errorf("unsupported member type %q", memberType)
+	}
+
+	if m := dynamicMembershipRuleDirectReportsRegex.FindStringSubmatch(rule); m != nil {
+		if memberType == DynamicMembershipRuleMemberTypeDevice {
+			return nil, DynamicMembershipRuleError{Position: 1, Message: "Direct Reports rules are only valid for user members"}
+		}
+		if _, err := uuid.ParseUUID(m[1]); err != nil {
+			return nil, DynamicMembershipRuleError{Position: strings.Index(rule, `"`) + 1, Message: fmt.Sprintf("expected the object ID of a manager, got %q", m[1])}
+		}
+		return &DynamicMembershipRuleInfo{
+			DirectReports: true,
+			MemberType:    DynamicMembershipRuleMemberTypeUser,
+			Properties:    []string{},
+		}, nil
+	}
+
+	tokens, err := tokenizeDynamicMembershipRule(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &dynamicMembershipRuleParser{
+		tokens: tokens,
+		info: DynamicMembershipRuleInfo{
+			MemberType: memberType,
+			Properties: make([]string, 0),
+		},
+		fixedMemberType: memberType != "",
+	}
+
+	if err = p.parseOr(nil); err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != dynamicMembershipRuleTokenEOF {
+		return nil, p.errorf(t, "unexpected %s", t)
+	}
+
+	if p.info.MemberOf && p.comparisons > 1 {
+		return nil, DynamicMembershipRuleError{Position: p.memberOfPosition, Message: "memberOf cannot be combined with other rules"}
+	}
+
+	return &p.info, nil
+}
+
+type dynamicMembershipRuleTokenKind int
+
+const (
+	dynamicMembershipRuleTokenEOF dynamicMembershipRuleTokenKind = iota
+	dynamicMembershipRuleTokenWord
+	dynamicMembershipRuleTokenString
+	dynamicMembershipRuleTokenLParen
+	dynamicMembershipRuleTokenRParen
+	dynamicMembershipRuleTokenLBracket
+	dynamicMembershipRuleTokenRBracket
+	dynamicMembershipRuleTokenComma
+)
+
+type dynamicMembershipRuleToken struct {
+	kind     dynamicMembershipRuleTokenKind
+	position int
+	text     string
+}
+
+func (t dynamicMembershipRuleToken) String() string {
+	switch t.kind {
+	case dynamicMembershipRuleTokenEOF:
+		return "end of rule"
+	case dynamicMembershipRuleTokenString:
+		return fmt.Sprintf("string %q", t.text)
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+func tokenizeDynamicMembershipRule(rule string) ([]dynamicMembershipRuleToken, error) {
+	tokens := make([]dynamicMembershipRuleToken, 0)
+	runes := []rune(rule)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		position := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(' || r == ')' || r == '[' || r == ']' || r == ',':
+			kind := map[rune]dynamicMembershipRuleTokenKind{
+				'(': dynamicMembershipRuleTokenLParen,
+				')': dynamicMembershipRuleTokenRParen,
+				'[': dynamicMembershipRuleTokenLBracket,
+				']': dynamicMembershipRuleTokenRBracket,
+				',': dynamicMembershipRuleTokenComma,
+			}[r]
+			tokens = append(tokens, dynamicMembershipRuleToken{kind: kind, position: position, text: string(r)})
+			i++
+
+		case r == '"' || r == '\'':
+			// Quoted values may contain the quote character when escaped with a backtick
+			var value strings.Builder
+			terminated := false
+			for i++; i < len(runes); i++ {
+				if runes[i] == '`' && i+1 < len(runes) {
+					i++
+					value.WriteRune(runes[i])
+					continue
+				}
+				if runes[i] == r {
+					terminated = true
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+			}
+			if !terminated {
+				return nil, DynamicMembershipRuleError{Position: position, Message: "unterminated string"}
+			}
+			tokens = append(tokens, dynamicMembershipRuleToken{kind: dynamicMembershipRuleTokenString, position: position, text: value.String()})
+
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("()[],\"'", runes[i]) {
+				i++
+			}
+			tokens = append(tokens, dynamicMembershipRuleToken{kind: dynamicMembershipRuleTokenWord, position: position, text: string(runes[start:i])})
+		}
+	}
+
+	tokens = append(tokens, dynamicMembershipRuleToken{kind: dynamicMembershipRuleTokenEOF, position: len(runes) + 1})
+
+	return tokens, nil
+}
+
+// dynamicMembershipRuleCollection describes the multivalued property being evaluated by an -any or -all expression
+type dynamicMembershipRuleCollection struct {
+	property   string
+	prefix     string
+	properties []string
+}
+
+type dynamicMembershipRuleParser struct {
+	tokens          []dynamicMembershipRuleToken
+	index           int
+	info            DynamicMembershipRuleInfo
+	fixedMemberType bool
+
+	comparisons      int
+	memberOfPosition int
+}
+
+func (p *dynamicMembershipRuleParser) peek() dynamicMembershipRuleToken {
+	return p.tokens[p.index]
+}
+
+func (p *dynamicMembershipRuleParser) next() dynamicMembershipRuleToken {
+	t := p.tokens[p.index]
+	if t.kind != dynamicMembershipRuleTokenEOF {
+		p.index++
+	}
+	return t
+}
+
+func (p *dynamicMembershipRuleParser) errorf(t dynamicMembershipRuleToken, format string, a ...interface{}) error {
+	return DynamicMembershipRuleError{Position: t.position, Message: fmt.Sprintf(format, a...)}
+}
+
+// unrecognisedf returns an error for syntax which is not known to the parser, but which may be valid nonetheless
+func (p *dynamicMembershipRuleParser) unrecognisedf(t dynamicMembershipRuleToken, format string, a ...interface{}) error {
+	return DynamicMembershipRuleError{Position: t.position, Message: fmt.Sprintf(format, a...), Unrecognised: true}
+}
+
+// isKeyword returns whether the next token is the specified keyword, which may optionally be prefixed with a hyphen
+func (p *dynamicMembershipRuleParser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == dynamicMembershipRuleTokenWord && strings.EqualFold(strings.TrimPrefix(t.text, "-"), keyword)
+}
+
+func (p *dynamicMembershipRuleParser) parseOr(collection *dynamicMembershipRuleCollection) error {
+	if err := p.parseAnd(collection); err != nil {
+		return err
+	}
+	for p.isKeyword("or") {
+		p.next()
+		if err := p.parseAnd(collection); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *dynamicMembershipRuleParser) parseAnd(collection *dynamicMembershipRuleCollection) error {
+	if err := p.parseUnary(collection); err != nil {
+		return err
+	}
+	for p.isKeyword("and") {
+		p.next()
+		if err := p.parseUnary(collection); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *dynamicMembershipRuleParser) parseUnary(collection *dynamicMembershipRuleCollection) error {
+	if p.isKeyword("not") {
+		p.next()
+		return p.parseUnary(collection)
+	}
+	return p.parsePrimary(collection)
+}
+
+func (p *dynamicMembershipRuleParser) parsePrimary(collection *dynamicMembershipRuleCollection) error {
+	if p.peek().kind == dynamicMembershipRuleTokenLParen {
+		p.next()
+		if err := p.parseOr(collection); err != nil {
+			return err
+		}
+		if t := p.next(); t.kind != dynamicMembershipRuleTokenRParen {
+			return p.errorf(t, "expected \")\", got %s", t)
+		}
+		return nil
+	}
+	return p.parseComparison(collection)
+}
+
+func (p *dynamicMembershipRuleParser) parseComparison(collection *dynamicMembershipRuleCollection) error {
+	propertyToken := p.next()
+	if propertyToken.kind != dynamicMembershipRuleTokenWord {
+		return p.errorf(propertyToken, "expected a property, got %s", propertyToken)
+	}
+
+	var multivalued bool
+	var propertyName string
+	if collection == nil {
+		var err error
+		if propertyName, multivalued, err = p.validateProperty(propertyToken); err != nil {
+			return err
+		}
+		p.comparisons++
+	} else if err := p.validateCollectionProperty(propertyToken, collection); err != nil {
+		return err
+	}
+
+	// Operators may optionally be prefixed with a hyphen
+	operatorToken := p.next()
+	operator := strings.ToLower(strings.TrimPrefix(operatorToken.text, "-"))
+	if operatorToken.kind != dynamicMembershipRuleTokenWord || !dynamicMembershipRuleOperators[operator] {
+		return p.unrecognisedf(operatorToken, "expected an operator such as -eq, got %s", operatorToken)
+	}
+
+	switch operator {
+	case "any", "all":
+		if collection != nil {
+			return p.errorf(operatorToken, "-%s cannot be nested within another -any or -all expression", operator)
+		}
+		if !multivalued {
+			return p.errorf(operatorToken, "-%s can only be used with multivalued properties, and %q is not multivalued", operator, propertyToken.text)
+		}
+		if propertyName == "memberof" && operator != "any" {
+			return p.errorf(operatorToken, "memberOf can only be used with the -any operator")
+		}
+
+		if t := p.next(); t.kind != dynamicMembershipRuleTokenLParen {
+			return p.errorf(t, "expected \"(\" following -%s, got %s", operator, t)
+		}
+
+		subCollection := &dynamicMembershipRuleCollection{property: propertyToken.text}
+		if v, ok := dynamicMembershipRuleCollectionProperties[propertyName]; ok {
+			subCollection.prefix = v.prefix
+			subCollection.properties = v.properties
+		}
+		if err := p.parseOr(subCollection); err != nil {
+			return err
+		}
+
+		if t := p.next(); t.kind != dynamicMembershipRuleTokenRParen {
+			return p.errorf(t, "expected \")\", got %s", t)
+		}
+
+	case "in", "notin":
+		if multivalued {
+			return p.errorf(operatorToken, "%q is multivalued and must be used with the -any or -all operators", propertyToken.text)
+		}
+		return p.parseArray(operatorToken)
+
+	default:
+		if multivalued {
+			return p.errorf(operatorToken, "%q is multivalued and must be used with the -any or -all operators", propertyToken.text)
+		}
+		return p.parseValue()
+	}
+
+	return nil
+}
+
+// validateProperty validates a top-level property reference such as `user.department`, returning the normalized
+// property name and whether it is multivalued
+func (p *dynamicMembershipRuleParser) validateProperty(t dynamicMembershipRuleToken) (string, bool, error) {
+	objectType, name, ok := strings.Cut(t.text, ".")
+	objectType = strings.ToLower(objectType)
+	if !ok || name == "" || (objectType != DynamicMembershipRuleMemberTypeUser && objectType != DynamicMembershipRuleMemberTypeDevice) {
+		return "", false, p.unrecognisedf(t, "expected a property such as \"user.department\" or \"device.deviceOSType\", got %s", t)
+	}
+
+	if p.info.MemberType == "" {
+		p.info.MemberType = objectType
+	} else if p.info.MemberType != objectType {
+		if p.fixedMemberType {
+			return "", false, p.errorf(t, "property %q is not valid for %s members", t.text, p.info.MemberType)
+		}
+		return "", false, p.errorf(t, "property %q is not valid in a rule for %s members, rules cannot combine user and device properties", t.text, p.info.MemberType)
+	}
+
+	name = strings.ToLower(name)
+	multivalued, known := dynamicMembershipRuleProperties[objectType][name]
+	if !known && !dynamicMembershipRuleExtensionAttrRegex.MatchString(name) && !dynamicMembershipRuleCustomExtensionRegex.MatchString(name) {
+		return "", false, p.errorf(t, "unknown property %q for %s members", t.text, objectType)
+	}
+
+	if name == "memberof" && !p.info.MemberOf {
+		p.info.MemberOf = true
+		p.memberOfPosition = t.position
+	}
+
+	p.info.Properties = append(p.info.Properties, t.text)
+
+	return name, multivalued, nil
+}
+
+// validateCollectionProperty validates a property reference within an -any or -all expression
+func (p *dynamicMembershipRuleParser) validateCollectionProperty(t dynamicMembershipRuleToken, collection *dynamicMembershipRuleCollection) error {
+	if collection.prefix == "" {
+		if t.text != "_" {
+			return p.errorf(t, "expected \"_\" to refer to each value of %q, got %s", collection.property, t)
+		}
+		return nil
+	}
+
+	prefix, name, ok := strings.Cut(t.text, ".")
+	if ok && strings.EqualFold(prefix, collection.prefix) {
+		for _, v := range collection.properties {
+			if strings.EqualFold(v, name) {
+				return nil
+			}
+		}
+	}
+
+	expected := make([]string, 0, len(collection.properties))
+	for _, v := range collection.properties {
+		expected = append(expected, fmt.Sprintf("%s.%s", collection.prefix, v))
+	}
+	return p.unrecognisedf(t, "expected one of [%s] within an expression for %q, got %s", strings.Join(expected, ", "), collection.property, t)
+}
+
+// parseValue parses a single value, which must be a quoted string, a boolean, null, a number, or `system.now`
+// optionally followed by -plus or -minus and an ISO 8601 duration
+func (p *dynamicMembershipRuleParser) parseValue() error {
+	t := p.next()
+	switch t.kind {
+	case dynamicMembershipRuleTokenString:
+		return nil
+	case dynamicMembershipRuleTokenWord:
+		switch strings.ToLower(t.text) {
+		case "true", "false", "null":
+			return nil
+		case "system.now":
+			return p.parseDateTimeOffset()
+		}
+		if _, err := strconv.ParseFloat(t.text, 64); err == nil {
+			return nil
+		}
+		return p.unrecognisedf(t, "expected a value, got %s (string values must be quoted)", t)
+	default:
+		return p.errorf(t, "expected a value, got %s", t)
+	}
+}
+
+// parseDateTimeOffset parses an optional -plus or -minus operator following `system.now`, along with its duration
+func (p *dynamicMembershipRuleParser) parseDateTimeOffset() error {
+	if !p.isKeyword("plus") && !p.isKeyword("minus") {
+		return nil
+	}
+	operatorToken := p.next()
+
+	t := p.next()
+	if t.kind != dynamicMembershipRuleTokenWord || !dynamicMembershipRuleDurationRegex.MatchString(t.text) || strings.EqualFold(t.text, "p") || strings.HasSuffix(strings.ToLower(t.text), "t") {
+		return p.errorf(t, "expected a duration such as \"p1d\" following %s, got %s", operatorToken.text, t)
+	}
+
+	return nil
+}
+
+// parseArray parses a non-empty list of values enclosed in square brackets
+func (p *dynamicMembershipRuleParser) parseArray(operatorToken dynamicMembershipRuleToken) error {
+	if t := p.next(); t.kind != dynamicMembershipRuleTokenLBracket {
+		return p.errorf(t, "expected \"[\" following %s, got %s", operatorToken.text, t)
+	}
+
+	if t := p.peek(); t.kind == dynamicMembershipRuleTokenRBracket {
+		return p.errorf(t, "expected at least one value for %s", operatorToken.text)
+	}
+
+	for {
+		if err := p.parseValue(); err != nil {
+			return err
+		}
+
+		t := p.next()
+		if t.kind == dynamicMembershipRuleTokenRBracket {
+			return nil
+		}
+		if t.kind != dynamicMembershipRuleTokenComma {
+			return p.errorf(t, "expected \",\" or \"]\", got %s", t)
+		}
+	}
+}
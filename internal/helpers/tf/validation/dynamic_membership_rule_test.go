@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseDynamicMembershipRule(t *testing.T) {
+	cases := []struct {
+		Rule         string
+		MemberType   string
+		TestName     string
+		Valid        bool
+		Position     int
+		Unrecognised bool
+		Expected     string
+	}{
+		{
+			Rule:     `user.department -eq "Sales"`,
+			TestName: "Simple",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeUser,
+		},
+		{
+			Rule:     `(user.department -eq "Sales") -and (user.country -in ["US", "GB"]) -or -not (user.accountEnabled -eq false)`,
+			TestName: "Compound",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeUser,
+		},
+		{
+			Rule:     `user.Department eq "Sales" and user.jobTitle startsWith 'Manager'`,
+			TestName: "OperatorsWithoutHyphens",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeUser,
+		},
+		{
+			Rule:     `user.displayName -match "^Sales.*" -and user.extensionAttribute15 -ne null`,
+			TestName: "MatchAndExtensionAttribute",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeUser,
+		},
+		{
+			Rule:     `user.extension_9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d_costCode -eq "1234"`,
+			TestName: "CustomExtensionProperty",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeUser,
+		},
+		{
+			Rule:     `user.assignedPlans -any (assignedPlan.servicePlanId -eq "efb87545-963c-4e0d-99df-69c6916d9eb0" -and assignedPlan.capabilityStatus -eq "Enabled")`,
+			TestName: "AssignedPlans",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeUser,
+		},
+		{
+			Rule:     `user.proxyAddresses -any (_ -contains "contoso")`,
+			TestName: "StringCollection",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeUser,
+		},
+		{
+			Rule:     `device.memberOf -any (group.objectId -in ["11111111-1111-1111-1111-111111111111"])`,
+			TestName: "MemberOf",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeDevice,
+		},
+		{
+			Rule:     `device.deviceOSType -eq "Windows" -and device.devicePhysicalIds -any (_ -startsWith "[ZTDId]")`,
+			TestName: "Device",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeDevice,
+		},
+		{
+			Rule:     "user.displayName -eq \"O`\"Brien\"",
+			TestName: "EscapedQuote",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeUser,
+		},
+		{
+			Rule:     `user.employeeHireDate -ge system.now -plus p1d`,
+			TestName: "SystemNowPlus",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeUser,
+		},
+		{
+			Rule:     `user.employeeHireDate -le system.now -minus P1Y2M3DT4H5M6S -and user.accountEnabled -eq true`,
+			TestName: "SystemNowMinus",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeUser,
+		},
+		{
+			Rule:     `user.employeeHireDate -ge system.now`,
+			TestName: "SystemNow",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeUser,
+		},
+		{
+			Rule:     `user.employeeHireDate -ge system.now -plus 1d`,
+			TestName: "SystemNowInvalidDuration",
+			Position: 44,
+		},
+		{
+			Rule:     `user.employeeHireDate -ge system.now -minus`,
+			TestName: "SystemNowMissingDuration",
+			Position: 44,
+		},
+		{
+			Rule:     `Direct Reports for "11111111-1111-1111-1111-111111111111"`,
+			TestName: "DirectReports",
+			Valid:    true,
+			Expected: DynamicMembershipRuleMemberTypeUser,
+		},
+		{
+			Rule:     `Direct Reports for "bob"`,
+			TestName: "DirectReportsInvalidManager",
+			Position: 20,
+		},
+		{
+			Rule:     `user.departmnet -eq "Sales"`,
+			TestName: "UnknownProperty",
+			Position: 1,
+		},
+		{
+			Rule:         `user.department -equals "Sales"`,
+			TestName:     "UnknownOperator",
+			Position:     17,
+			Unrecognised: true,
+		},
+		{
+			Rule:         `user.department -eq Sales`,
+			TestName:     "UnquotedString",
+			Position:     21,
+			Unrecognised: true,
+		},
+		{
+			Rule:     `user.department -eq "Sales`,
+			TestName: "UnterminatedString",
+			Position: 21,
+		},
+		{
+			Rule:     `(user.department -eq "Sales"`,
+			TestName: "UnbalancedParentheses",
+			Position: 29,
+		},
+		{
+			Rule:     `user.department -eq "Sales" user.city -eq "Leeds"`,
+			TestName: "MissingConjunction",
+			Position: 29,
+		},
+		{
+			Rule:     `user.country -in []`,
+			TestName: "EmptyArray",
+			Position: 19,
+		},
+		{
+			Rule:     `user.country -in "US"`,
+			TestName: "InWithoutArray",
+			Position: 18,
+		},
+		{
+			Rule:     `user.department -any (_ -eq "Sales")`,
+			TestName: "AnyOnSingleValuedProperty",
+			Position: 17,
+		},
+		{
+			Rule:     `user.proxyAddresses -contains "contoso"`,
+			TestName: "MultivaluedWithoutAny",
+			Position: 21,
+		},
+		{
+			Rule:         `user.assignedPlans -any (plan.servicePlanId -eq "x")`,
+			TestName:     "WrongCollectionProperty",
+			Position:     26,
+			Unrecognised: true,
+		},
+		{
+			Rule:     `user.memberOf -any (group.objectId -in ["11111111-1111-1111-1111-111111111111"]) -and user.department -eq "Sales"`,
+			TestName: "MemberOfCombined",
+			Position: 1,
+		},
+		{
+			Rule:     `user.department -eq "Sales" -or device.deviceOSType -eq "Windows"`,
+			TestName: "MixedMemberTypes",
+			Position: 33,
+		},
+		{
+			Rule:       `user.department -eq "Sales"`,
+			MemberType: DynamicMembershipRuleMemberTypeDevice,
+			TestName:   "WrongMemberType",
+			Position:   1,
+		},
+		{
+			Rule:         `group.displayName -eq "Sales"`,
+			TestName:     "UnsupportedObjectType",
+			Position:     1,
+			Unrecognised: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			info, err := ParseDynamicMembershipRule(tc.Rule, tc.MemberType)
+
+			if tc.Valid {
+				if err != nil {
+					t.Fatalf("expected rule %q to be valid, got error: %v", tc.Rule, err)
+				}
+				if info.MemberType != tc.Expected {
+					t.Fatalf("expected member type %q for rule %q, got %q", tc.Expected, tc.Rule, info.MemberType)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected rule %q to be invalid", tc.Rule)
+			}
+			var ruleErr DynamicMembershipRuleError
+			if !errors.As(err, &ruleErr) {
+				t.Fatalf("expected a DynamicMembershipRuleError for rule %q, got: %v", tc.Rule, err)
+			}
+			if ruleErr.Position != tc.Position {
+				t.Fatalf("expected error at position %d for rule %q, got: %v", tc.Position, tc.Rule, err)
+			}
+			if ruleErr.Unrecognised != tc.Unrecognised {
+				t.Fatalf("expected unrecognised to be %t for rule %q, got: %v", tc.Unrecognised, tc.Rule, err)
+			}
+		})
+	}
+}
+
+func TestDynamicMembershipRule(t *testing.T) {
+	cases := []struct {
+		Value     interface{}
+		TestName  string
+		ErrCount  int
+		WarnCount int
+	}{
+		{
+			Value:    "",
+			TestName: "Empty",
+			ErrCount: 0,
+		},
+		{
+			Value:    `user.accountEnabled -eq true`,
+			TestName: "Valid",
+			ErrCount: 0,
+		},
+		{
+			Value:    `user.accountEnabled -eq`,
+			TestName: "MissingValue",
+			ErrCount: 1,
+		},
+		{
+			Value:    `user.accountEnabld -eq true`,
+			TestName: "UnknownProperty",
+			ErrCount: 1,
+		},
+		{
+			Value:    `user.department -eq "Sales" -or device.deviceOSType -eq "Windows"`,
+			TestName: "MixedMemberTypes",
+			ErrCount: 1,
+		},
+		{
+			Value:    `(user.department -eq "Sales"`,
+			TestName: "UnbalancedParentheses",
+			ErrCount: 1,
+		},
+		{
+			Value:    `user.country -in ["US", "GB"`,
+			TestName: "UnbalancedBrackets",
+			ErrCount: 1,
+		},
+		{
+			Value:    `user.department -eq "Sales`,
+			TestName: "UnterminatedString",
+			ErrCount: 1,
+		},
+		{
+			Value:     `user.department -equals "Sales"`,
+			TestName:  "UnknownOperator",
+			ErrCount:  0,
+			WarnCount: 1,
+		},
+		{
+			Value:     `user.department -eq toLower("Sales")`,
+			TestName:  "UnknownFunction",
+			ErrCount:  0,
+			WarnCount: 1,
+		},
+		{
+			Value:    1,
+			TestName: "NotAString",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			warnings, errors := DynamicMembershipRule(tc.Value, "rule")
+
+			if len(errors) != tc.ErrCount {
+				t.Fatalf("Expected DynamicMembershipRule to have %d not %d errors for %q", tc.ErrCount, len(errors), tc.TestName)
+			}
+			if len(warnings) != tc.WarnCount {
+				t.Fatalf("Expected DynamicMembershipRule to have %d not %d warnings for %q", tc.WarnCount, len(warnings), tc.TestName)
+			}
+		})
+	}
+}
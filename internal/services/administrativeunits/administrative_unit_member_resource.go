@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -93,6 +94,10 @@ func administrativeUnitMemberResourceCreate(ctx context.Context, d *pluginsdk.Re
 		return tf.ErrorDiagPathF(err, "object_id", "Retrieving administrative unit with object ID: %q", id.AdministrativeUnitId)
 	}
 
+	if resp.Model != nil && strings.EqualFold(resp.Model.MembershipType.GetOrZero(), administrativeUnitMembershipTypeDynamic) {
+		return tf.ErrorDiagPathF(nil, "administrative_unit_object_id", "Members cannot be added to administrative unit with object ID %q as it has dynamic membership", id.AdministrativeUnitId)
+	}
+
 	if member, err := administrativeUnitGetMember(ctx, memberClient, id); err != nil {
 		return tf.ErrorDiagF(err, "Checking for existing %s", id)
 	} else if member != nil {
@@ -83,12 +83,37 @@ func administrativeUnitResource() *pluginsdk.Resource {
 				Optional:    true,
 			},
 
+			"dynamic_membership": {
+				Description:   "An optional block to configure dynamic membership for the administrative unit. Cannot be used with `members`",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"members"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Description: "Whether rule processing is `On` (true) or `Paused` (false)",
+							Type:        pluginsdk.TypeBool,
+							Required:    true,
+						},
+
+						"rule": {
+							Description:  "Rule to determine members for a dynamic administrative unit",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.All(validation.StringLenBetween(0, 3072), validation.DynamicMembershipRule),
+						},
+					},
+				},
+			},
+
 			"members": {
-				Description: "A set of object IDs of members who should be present in this administrative unit. Supported object types are Users or Groups",
-				Type:        pluginsdk.TypeSet,
-				Optional:    true,
-				Computed:    true,
-				Set:         pluginsdk.HashString,
+				Description:   "A set of object IDs of members who should be present in this administrative unit. Supported object types are Users or Groups",
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"dynamic_membership"},
+				Set:           pluginsdk.HashString,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.IsUUID,
@@ -179,6 +204,15 @@ func administrativeUnitResourceCreate(ctx context.Context, d *pluginsdk.Resource
 		properties.Visibility = nullable.Value(administrativeUnitVisibilityHiddenMembership)
 	}
 
+	if v, ok := d.GetOk("dynamic_membership"); ok && len(v.([]interface{})) > 0 {
+		properties.MembershipType = nullable.Value(administrativeUnitMembershipTypeDynamic)
+		properties.MembershipRule = nullable.Value(d.Get("dynamic_membership.0.rule").(string))
+		properties.MembershipRuleProcessingState = nullable.Value(administrativeUnitMembershipRuleProcessingStatePaused)
+		if d.Get("dynamic_membership.0.enabled").(bool) {
+			properties.MembershipRuleProcessingState = nullable.Value(administrativeUnitMembershipRuleProcessingStateOn)
+		}
+	}
+
 	resp, err := client.CreateAdministrativeUnit(ctx, properties, administrativeunit.DefaultCreateAdministrativeUnitOperationOptions())
 	if err != nil {
 		return tf.ErrorDiagF(err, "Creating administrative unit %q", displayName)
@@ -295,11 +329,27 @@ func administrativeUnitResourceUpdate(ctx context.Context, d *pluginsdk.Resource
 		administrativeUnit.Visibility = nullable.Value(administrativeUnitVisibilityHiddenMembership)
 	}
 
+	dynamicMembership := false
+	if v, ok := d.GetOk("dynamic_membership"); ok && len(v.([]interface{})) > 0 {
+		dynamicMembership = true
+		administrativeUnit.MembershipType = nullable.Value(administrativeUnitMembershipTypeDynamic)
+		administrativeUnit.MembershipRule = nullable.Value(d.Get("dynamic_membership.0.rule").(string))
+		administrativeUnit.MembershipRuleProcessingState = nullable.Value(administrativeUnitMembershipRuleProcessingStatePaused)
+		if d.Get("dynamic_membership.0.enabled").(bool) {
+			administrativeUnit.MembershipRuleProcessingState = nullable.Value(administrativeUnitMembershipRuleProcessingStateOn)
+		}
+	} else if d.HasChange("dynamic_membership") {
+		// Dynamic membership was removed, so revert to assigned membership
+		administrativeUnit.MembershipType = nullable.Value(administrativeUnitMembershipTypeAssigned)
+		administrativeUnit.MembershipRule = nullable.NoZero("")
+	}
+
 	if _, err := client.UpdateAdministrativeUnit(ctx, *id, administrativeUnit, administrativeunit.DefaultUpdateAdministrativeUnitOperationOptions()); err != nil {
 		return tf.ErrorDiagF(err, "Updating %s", id)
 	}
 
-	if d.HasChange("members") {
+	// Members of a dynamic administrative unit are managed by the membership rule
+	if d.HasChange("members") && !dynamicMembership {
 		membersResp, err := memberClient.ListAdministrativeUnitMembers(ctx, *id, administrativeunitmember.DefaultListAdministrativeUnitMembersOperationOptions())
 		if err != nil {
 			return tf.ErrorDiagF(err, "Could not retrieve members for %s", id)
@@ -362,6 +412,15 @@ func administrativeUnitResourceRead(ctx context.Context, d *pluginsdk.ResourceDa
 	hiddenMembershipEnabled := strings.EqualFold(administrativeUnit.Visibility.GetOrZero(), administrativeUnitVisibilityHiddenMembership)
 	tf.Set(d, "hidden_membership_enabled", hiddenMembershipEnabled)
 
+	dynamicMembership := make([]interface{}, 0)
+	if strings.EqualFold(administrativeUnit.MembershipType.GetOrZero(), administrativeUnitMembershipTypeDynamic) {
+		dynamicMembership = append(dynamicMembership, map[string]interface{}{
+			"enabled": !strings.EqualFold(administrativeUnit.MembershipRuleProcessingState.GetOrZero(), administrativeUnitMembershipRuleProcessingStatePaused),
+			"rule":    administrativeUnit.MembershipRule.GetOrZero(),
+		})
+	}
+	tf.Set(d, "dynamic_membership", dynamicMembership)
+
 	membersResp, err := memberClient.ListAdministrativeUnitMembers(ctx, *id, administrativeunitmember.DefaultListAdministrativeUnitMembersOperationOptions())
 	if err != nil {
 		return tf.ErrorDiagF(err, "Could not retrieve members for %s", id)
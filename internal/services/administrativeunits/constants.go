@@ -3,6 +3,16 @@
 
 package administrativeunits
 
+const (
+	administrativeUnitMembershipTypeAssigned = "Assigned"
+	administrativeUnitMembershipTypeDynamic  = "Dynamic"
+)
+
+const (
+	administrativeUnitMembershipRuleProcessingStateOn     = "On"
+	administrativeUnitMembershipRuleProcessingStatePaused = "Paused"
+)
+
 const (
 	administrativeUnitVisibilityHiddenMembership = "HiddenMembership"
 	administrativeUnitVisibilityPublic           = "Public"
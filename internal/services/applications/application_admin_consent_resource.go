@@ -0,0 +1,814 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/oauth2permissiongrants/stable/oauth2permissiongrant"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignedto"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/sdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/applications/client"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/applications/parse"
+	appRoleAssignmentsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/approleassignments/client"
+)
+
+const delegatedPermissionGrantConsentTypeAllPrincipals = "AllPrincipals"
+
+type ApplicationAdminConsentModel struct {
+	ApplicationId            string                                       `tfschema:"application_id"`
+	ServicePrincipalObjectId string                                       `tfschema:"service_principal_object_id"`
+	Triggers                 map[string]string                            `tfschema:"triggers"`
+	ConsentedResourceAccess  []ApplicationAdminConsentResourceAccessModel `tfschema:"consented_resource_access"`
+	AddedResourceAccess      []ApplicationAdminConsentResourceAccessModel `tfschema:"added_resource_access"`
+}
+
+type ApplicationAdminConsentResourceAccessModel struct {
+	ResourceAppId string   `tfschema:"resource_app_id"`
+	RoleIds       []string `tfschema:"role_ids"`
+	ScopeIds      []string `tfschema:"scope_ids"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = ApplicationAdminConsentResource{}
+	_ sdk.ResourceWithCustomizeDiff = ApplicationAdminConsentResource{}
+)
+
+type ApplicationAdminConsentResource struct{}
+
+func (r ApplicationAdminConsentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateAdminConsentID
+}
+
+func (r ApplicationAdminConsentResource) ResourceType() string {
+	return "azuread_application_admin_consent"
+}
+
+func (r ApplicationAdminConsentResource) ModelObject() interface{} {
+	return &ApplicationAdminConsentModel{}
+}
+
+func (r ApplicationAdminConsentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"application_id": {
+			Description:  "The resource ID of the application for which to grant admin consent",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: stable.ValidateApplicationID,
+		},
+
+		"triggers": {
+			Description: "A map of arbitrary values that, when changed, will cause admin consent to be granted again",
+			Type:        pluginsdk.TypeMap,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"service_principal_object_id": {
+			Description: "The object ID of the service principal for the application",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"consented_resource_access": {
+			Description: "The API permissions for which admin consent has been granted",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem:        applicationAdminConsentResourceAccessSchema(),
+		},
+
+		"added_resource_access": {
+			Description: "The API permissions for which admin consent was granted by this resource, and which are revoked when no longer requested or when this resource is destroyed",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem:        applicationAdminConsentResourceAccessSchema(),
+		},
+	}
+}
+
+func applicationAdminConsentResourceAccessSchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_app_id": {
+				Description: "The client ID of the API",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"role_ids": {
+				Description: "The IDs of the app roles for which admin consent has been granted",
+				Type:        pluginsdk.TypeSet,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"scope_ids": {
+				Description: "The IDs of the delegated permission scopes for which admin consent has been granted",
+				Type:        pluginsdk.TypeSet,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationClient
+			rd := metadata.ResourceDiff
+
+			// New resources and replacements are consented in full when applied
+			if rd.Id() == "" || rd.HasChange("application_id") {
+				return nil
+			}
+
+			id, err := parse.ParseAdminConsentID(rd.Id())
+			if err != nil {
+				return err
+			}
+
+			applicationId := stable.NewApplicationID(id.ApplicationId)
+
+			resp, err := client.GetApplication(ctx, applicationId, application.DefaultGetApplicationOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", applicationId, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", applicationId)
+			}
+
+			// Show any API permissions that are requested but not consented, or consented but no longer requested
+			requested := flattenAdminConsentResourceAccess(expandAdminConsentRequiredResourceAccess(resp.Model.RequiredResourceAccess))
+			consented := flattenAdminConsentResourceAccess(expandAdminConsentResourceAccess(rd.Get("consented_resource_access").([]interface{})))
+			if !reflect.DeepEqual(requested, consented) {
+				if err = rd.SetNew("consented_resource_access", requested); err != nil {
+					return fmt.Errorf("setting `consented_resource_access`: %+v", err)
+				}
+				if err = rd.SetNewComputed("added_resource_access"); err != nil {
+					return fmt.Errorf("setting `added_resource_access`: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 15 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ApplicationAdminConsentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			applicationId, err := stable.ParseApplicationID(model.ApplicationId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewAdminConsentID(applicationId.ApplicationId)
+
+			added, err := applicationAdminConsentApply(ctx, metadata.Client.Applications, *applicationId, nil, false)
+			if err != nil {
+				return fmt.Errorf("granting %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			// Record the API permissions consented by this resource, so that consent which was granted before this
+			// resource was created is never revoked
+			model.AddedResourceAccess = flattenAdminConsentResourceAccessModel(added)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications
+
+			id, err := parse.ParseAdminConsentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			applicationId := stable.NewApplicationID(id.ApplicationId)
+
+			resp, err := client.ApplicationClient.GetApplication(ctx, applicationId, application.DefaultGetApplicationOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", applicationId, err)
+			}
+			app := resp.Model
+			if app == nil {
+				return fmt.Errorf("retrieving %s: model was nil", applicationId)
+			}
+
+			servicePrincipal, err := applicationAdminConsentServicePrincipal(ctx, client, app.AppId.GetOrZero())
+			if err != nil {
+				return err
+			}
+			if servicePrincipal == nil {
+				return metadata.MarkAsGone(id)
+			}
+			servicePrincipalId := pointer.From(servicePrincipal.Id)
+
+			// Only API permissions that are requested, or that were previously consented or added by this resource, are considered
+			requested := expandAdminConsentRequiredResourceAccess(app.RequiredResourceAccess)
+			previous := expandAdminConsentResourceAccess(metadata.ResourceData.Get("consented_resource_access").([]interface{}))
+			added := expandAdminConsentResourceAccess(metadata.ResourceData.Get("added_resource_access").([]interface{}))
+
+			granted, err := applicationAdminConsentGranted(ctx, client, servicePrincipalId, mergeAdminConsentResourceAccess(requested, previous, added))
+			if err != nil {
+				return err
+			}
+
+			state := ApplicationAdminConsentModel{
+				ApplicationId:            applicationId.ID(),
+				ServicePrincipalObjectId: servicePrincipalId,
+				Triggers:                 make(map[string]string),
+				ConsentedResourceAccess:  flattenAdminConsentResourceAccessModel(granted),
+				AddedResourceAccess:      flattenAdminConsentResourceAccessModel(intersectAdminConsentResourceAccess(added, granted)),
+			}
+
+			for k, v := range metadata.ResourceData.Get("triggers").(map[string]interface{}) {
+				state.Triggers[k] = v.(string)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 15 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseAdminConsentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationAdminConsentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			applicationId := stable.NewApplicationID(id.ApplicationId)
+			previous := expandAdminConsentResourceAccess(metadata.ResourceData.Get("added_resource_access").([]interface{}))
+
+			added, err := applicationAdminConsentApply(ctx, metadata.Client.Applications, applicationId, previous, false)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			model.AddedResourceAccess = flattenAdminConsentResourceAccessModel(added)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 15 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseAdminConsentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			applicationId := stable.NewApplicationID(id.ApplicationId)
+			previous := expandAdminConsentResourceAccess(metadata.ResourceData.Get("added_resource_access").([]interface{}))
+
+			if _, err = applicationAdminConsentApply(ctx, metadata.Client.Applications, applicationId, previous, true); err != nil {
+				return fmt.Errorf("revoking %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// adminConsentResourceAccess holds the app role IDs and permission scope IDs for a single API
+type adminConsentResourceAccess struct {
+	resourceAppId string
+	roleIds       map[string]bool
+	scopeIds      map[string]bool
+}
+
+// adminConsentResourceAccessMap holds the API permissions for any number of APIs, keyed by lower-cased client ID
+type adminConsentResourceAccessMap map[string]*adminConsentResourceAccess
+
+func (m adminConsentResourceAccessMap) get(resourceAppId string) *adminConsentResourceAccess {
+	key := strings.ToLower(resourceAppId)
+	if _, ok := m[key]; !ok {
+		m[key] = &adminConsentResourceAccess{
+			resourceAppId: resourceAppId,
+			roleIds:       make(map[string]bool),
+			scopeIds:      make(map[string]bool),
+		}
+	}
+	return m[key]
+}
+
+func expandAdminConsentRequiredResourceAccess(in *[]stable.RequiredResourceAccess) adminConsentResourceAccessMap {
+	result := make(adminConsentResourceAccessMap)
+	for _, api := range pointer.From(in) {
+		access := result.get(pointer.From(api.ResourceAppId))
+		for _, permission := range pointer.From(api.ResourceAccess) {
+			switch permission.Type.GetOrZero() {
+			case ResourceAccessTypeRole:
+				access.roleIds[strings.ToLower(pointer.From(permission.Id))] = true
+			case ResourceAccessTypeScope:
+				access.scopeIds[strings.ToLower(pointer.From(permission.Id))] = true
+			}
+		}
+	}
+	return result
+}
+
+func expandAdminConsentResourceAccess(in []interface{}) adminConsentResourceAccessMap {
+	result := make(adminConsentResourceAccessMap)
+	for _, raw := range in {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		access := result.get(v["resource_app_id"].(string))
+		if roleIds, ok := v["role_ids"].(*pluginsdk.Set); ok {
+			for _, roleId := range roleIds.List() {
+				access.roleIds[strings.ToLower(roleId.(string))] = true
+			}
+		}
+		if scopeIds, ok := v["scope_ids"].(*pluginsdk.Set); ok {
+			for _, scopeId := range scopeIds.List() {
+				access.scopeIds[strings.ToLower(scopeId.(string))] = true
+			}
+		}
+	}
+	return result
+}
+
+func mergeAdminConsentResourceAccess(in ...adminConsentResourceAccessMap) adminConsentResourceAccessMap {
+	result := make(adminConsentResourceAccessMap)
+	for _, m := range in {
+		for _, v := range m {
+			access := result.get(v.resourceAppId)
+			for roleId := range v.roleIds {
+				access.roleIds[roleId] = true
+			}
+			for scopeId := range v.scopeIds {
+				access.scopeIds[scopeId] = true
+			}
+		}
+	}
+	return result
+}
+
+// flattenAdminConsentResourceAccess returns a list of API permissions sorted by client ID, omitting any APIs without
+// permissions, so that the result can be compared and used in state
+func flattenAdminConsentResourceAccess(in adminConsentResourceAccessMap) []interface{} {
+	keys := make([]string, 0)
+	for key, access := range in {
+		if len(access.roleIds) > 0 || len(access.scopeIds) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	result := make([]interface{}, 0)
+	for _, key := range keys {
+		access := in[key]
+
+		roleIds := make([]string, 0)
+		for roleId := range access.roleIds {
+			roleIds = append(roleIds, roleId)
+		}
+		sort.Strings(roleIds)
+
+		scopeIds := make([]string, 0)
+		for scopeId := range access.scopeIds {
+			scopeIds = append(scopeIds, scopeId)
+		}
+		sort.Strings(scopeIds)
+
+		result = append(result, map[string]interface{}{
+			"resource_app_id": key,
+			"role_ids":        roleIds,
+			"scope_ids":       scopeIds,
+		})
+	}
+
+	return result
+}
+
+func flattenAdminConsentResourceAccessModel(in adminConsentResourceAccessMap) []ApplicationAdminConsentResourceAccessModel {
+	result := make([]ApplicationAdminConsentResourceAccessModel, 0)
+	for _, v := range flattenAdminConsentResourceAccess(in) {
+		access := v.(map[string]interface{})
+		result = append(result, ApplicationAdminConsentResourceAccessModel{
+			ResourceAppId: access["resource_app_id"].(string),
+			RoleIds:       access["role_ids"].([]string),
+			ScopeIds:      access["scope_ids"].([]string),
+		})
+	}
+	return result
+}
+
+// intersectAdminConsentResourceAccess returns the API permissions which are present in both a and b
+func intersectAdminConsentResourceAccess(a, b adminConsentResourceAccessMap) adminConsentResourceAccessMap {
+	result := make(adminConsentResourceAccessMap)
+	for _, v := range a {
+		other := b.get(v.resourceAppId)
+		access := result.get(v.resourceAppId)
+		for roleId := range v.roleIds {
+			if other.roleIds[roleId] {
+				access.roleIds[roleId] = true
+			}
+		}
+		for scopeId := range v.scopeIds {
+			if other.scopeIds[scopeId] {
+				access.scopeIds[scopeId] = true
+			}
+		}
+	}
+	return result
+}
+
+// applicationAdminConsentServicePrincipal returns the service principal for the application with the given client ID,
+// or nil when it does not exist
+func applicationAdminConsentServicePrincipal(ctx context.Context, c *client.Client, clientId string) (*stable.ServicePrincipal, error) {
+	options := serviceprincipal.ListServicePrincipalsOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(clientId))),
+	}
+
+	resp, err := c.ServicePrincipalClient.ListServicePrincipals(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving service principal for application with client ID %q: %+v", clientId, err)
+	}
+
+	for _, servicePrincipal := range pointer.From(resp.Model) {
+		if strings.EqualFold(servicePrincipal.AppId.GetOrZero(), clientId) {
+			return &servicePrincipal, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// applicationAdminConsentGranted returns the API permissions that are currently consented for the service principal,
+// limited to those in the provided candidates
+func applicationAdminConsentGranted(ctx context.Context, c *client.Client, servicePrincipalId string, candidates adminConsentResourceAccessMap) (adminConsentResourceAccessMap, error) {
+	assignmentsResp, err := c.AppRoleAssignmentClient.ListAppRoleAssignments(ctx, pointer.To(stable.NewServicePrincipalID(servicePrincipalId)), appRoleAssignmentsClient.ListAppRoleAssignmentsOperationOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing app role assignments for service principal with object ID %q: %+v", servicePrincipalId, err)
+	}
+
+	grants, err := applicationAdminConsentGrants(ctx, c, servicePrincipalId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(adminConsentResourceAccessMap)
+
+	for _, candidate := range candidates {
+		resource, err := applicationAdminConsentServicePrincipal(ctx, c, candidate.resourceAppId)
+		if err != nil {
+			return nil, err
+		}
+		if resource == nil {
+			continue
+		}
+		resourceId := pointer.From(resource.Id)
+		access := result.get(candidate.resourceAppId)
+
+		for _, assignment := range pointer.From(assignmentsResp.Model) {
+			roleId := strings.ToLower(pointer.From(assignment.AppRoleId))
+			if strings.EqualFold(assignment.ResourceId.GetOrZero(), resourceId) && candidate.roleIds[roleId] {
+				access.roleIds[roleId] = true
+			}
+		}
+
+		if grant, ok := grants[strings.ToLower(resourceId)]; ok {
+			scopeIds := applicationAdminConsentScopeIds(resource)
+			for _, value := range tf.FromSpaceSeparated(grant.Scope.GetOrZero()) {
+				if scopeId, ok := scopeIds[value]; ok && candidate.scopeIds[scopeId] {
+					access.scopeIds[scopeId] = true
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// applicationAdminConsentGrants returns the tenant-wide delegated permission grants for the service principal, keyed
+// by the lower-cased object ID of the resource service principal
+func applicationAdminConsentGrants(ctx context.Context, c *client.Client, servicePrincipalId string) (map[string]stable.OAuth2PermissionGrant, error) {
+	options := oauth2permissiongrant.ListOAuth2PermissionGrantsOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("clientId eq '%s'", odata.EscapeSingleQuote(servicePrincipalId))),
+	}
+
+	resp, err := c.OAuth2PermissionGrantClient.ListOAuth2PermissionGrants(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing delegated permission grants for service principal with object ID %q: %+v", servicePrincipalId, err)
+	}
+
+	result := make(map[string]stable.OAuth2PermissionGrant)
+	for _, grant := range pointer.From(resp.Model) {
+		if grant.ConsentType.GetOrZero() == delegatedPermissionGrantConsentTypeAllPrincipals {
+			result[strings.ToLower(pointer.From(grant.ResourceId))] = grant
+		}
+	}
+
+	return result, nil
+}
+
+// applicationAdminConsentScopeIds returns a map of permission scope values to lower-cased permission scope IDs
+func applicationAdminConsentScopeIds(resource *stable.ServicePrincipal) map[string]string {
+	result := make(map[string]string)
+	for _, scope := range pointer.From(resource.OAuth2PermissionScopes) {
+		if value := scope.Value.GetOrZero(); value != "" && scope.Id != nil {
+			result[value] = strings.ToLower(*scope.Id)
+		}
+	}
+	return result
+}
+
+// applicationAdminConsentApply grants admin consent for all API permissions requested by the application, and revokes
+// any API permissions previously added by this resource that are no longer requested. When revokeAll is true, all API
+// permissions previously added by this resource are revoked instead. API permissions that were already consented
+// before being requested are never revoked. The API permissions added by this resource are returned.
+func applicationAdminConsentApply(ctx context.Context, c *client.Client, applicationId stable.ApplicationId, previous adminConsentResourceAccessMap, revokeAll bool) (adminConsentResourceAccessMap, error) {
+	added := mergeAdminConsentResourceAccess(previous)
+
+	resp, err := c.ApplicationClient.GetApplication(ctx, applicationId, application.DefaultGetApplicationOperationOptions())
+	if err != nil {
+		if revokeAll && response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", applicationId, err)
+	}
+	app := resp.Model
+	if app == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", applicationId)
+	}
+
+	servicePrincipal, err := applicationAdminConsentServicePrincipal(ctx, c, app.AppId.GetOrZero())
+	if err != nil {
+		return nil, err
+	}
+	if servicePrincipal == nil {
+		if revokeAll {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("service principal was not found for %s, a service principal must exist before admin consent can be granted", applicationId)
+	}
+	servicePrincipalId := pointer.From(servicePrincipal.Id)
+
+	requested := make(adminConsentResourceAccessMap)
+	if !revokeAll {
+		requested = expandAdminConsentRequiredResourceAccess(app.RequiredResourceAccess)
+	}
+	if previous == nil {
+		previous = make(adminConsentResourceAccessMap)
+	}
+
+	assignmentsResp, err := c.AppRoleAssignmentClient.ListAppRoleAssignments(ctx, pointer.To(stable.NewServicePrincipalID(servicePrincipalId)), appRoleAssignmentsClient.ListAppRoleAssignmentsOperationOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing app role assignments for service principal with object ID %q: %+v", servicePrincipalId, err)
+	}
+	assignments := pointer.From(assignmentsResp.Model)
+
+	grants, err := applicationAdminConsentGrants(ctx, c, servicePrincipalId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, access := range mergeAdminConsentResourceAccess(requested, previous) {
+		wanted := requested.get(access.resourceAppId)
+		revoke := previous.get(access.resourceAppId)
+
+		resource, err := applicationAdminConsentServicePrincipal(ctx, c, access.resourceAppId)
+		if err != nil {
+			return nil, err
+		}
+		if resource == nil {
+			if len(wanted.roleIds) > 0 || len(wanted.scopeIds) > 0 {
+				return nil, fmt.Errorf("service principal was not found for API with client ID %q, a service principal must exist before admin consent can be granted", access.resourceAppId)
+			}
+			continue
+		}
+		resourceId := pointer.From(resource.Id)
+
+		// App roles
+		appRoleIds := make(map[string]bool)
+		for _, appRole := range pointer.From(resource.AppRoles) {
+			appRoleIds[strings.ToLower(pointer.From(appRole.Id))] = true
+		}
+
+		assigned := make(map[string]string)
+		for _, assignment := range assignments {
+			if strings.EqualFold(assignment.ResourceId.GetOrZero(), resourceId) {
+				assigned[strings.ToLower(pointer.From(assignment.AppRoleId))] = pointer.From(assignment.Id)
+			}
+		}
+
+		for roleId := range wanted.roleIds {
+			if !appRoleIds[roleId] {
+				return nil, fmt.Errorf("app role with ID %q was not found for API with client ID %q", roleId, access.resourceAppId)
+			}
+			if _, ok := assigned[roleId]; ok {
+				continue
+			}
+			if err = applicationAdminConsentAssignAppRole(ctx, c, resourceId, servicePrincipalId, roleId); err != nil {
+				return nil, err
+			}
+			added.get(access.resourceAppId).roleIds[roleId] = true
+		}
+
+		for roleId := range revoke.roleIds {
+			if wanted.roleIds[roleId] {
+				continue
+			}
+			delete(added.get(access.resourceAppId).roleIds, roleId)
+			assignmentId, ok := assigned[roleId]
+			if !ok {
+				continue
+			}
+			id := stable.NewServicePrincipalIdAppRoleAssignedToID(resourceId, assignmentId)
+			if resp, err := c.AppRoleAssignedToClient.DeleteAppRoleAssignedTo(ctx, id, approleassignedto.DefaultDeleteAppRoleAssignedToOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return nil, fmt.Errorf("removing %s: %+v", id, err)
+			}
+		}
+
+		// Delegated permission scopes
+		scopeIds := applicationAdminConsentScopeIds(resource)
+		scopeValues := make(map[string]string)
+		for value, scopeId := range scopeIds {
+			scopeValues[scopeId] = value
+		}
+
+		for scopeId := range wanted.scopeIds {
+			if _, ok := scopeValues[scopeId]; !ok {
+				return nil, fmt.Errorf("delegated permission scope with ID %q was not found for API with client ID %q", scopeId, access.resourceAppId)
+			}
+		}
+
+		grant, grantExists := grants[strings.ToLower(resourceId)]
+
+		// Retain any scopes in an existing grant that were not added by this resource
+		claimValues := make([]string, 0)
+		seen := make(map[string]bool)
+		if grantExists {
+			for _, value := range tf.FromSpaceSeparated(grant.Scope.GetOrZero()) {
+				if scopeId, ok := scopeIds[value]; ok && revoke.scopeIds[scopeId] && !wanted.scopeIds[scopeId] {
+					continue
+				}
+				if !seen[value] {
+					seen[value] = true
+					claimValues = append(claimValues, value)
+				}
+			}
+		}
+		for scopeId := range revoke.scopeIds {
+			if !wanted.scopeIds[scopeId] {
+				delete(added.get(access.resourceAppId).scopeIds, scopeId)
+			}
+		}
+		for scopeId := range wanted.scopeIds {
+			if value := scopeValues[scopeId]; !seen[value] {
+				seen[value] = true
+				claimValues = append(claimValues, value)
+				added.get(access.resourceAppId).scopeIds[scopeId] = true
+			}
+		}
+		sort.Strings(claimValues)
+
+		if err = applicationAdminConsentGrantScopes(ctx, c, servicePrincipalId, resourceId, grant, grantExists, claimValues); err != nil {
+			return nil, err
+		}
+	}
+
+	return added, nil
+}
+
+func applicationAdminConsentAssignAppRole(ctx context.Context, c *client.Client, resourceId, principalId, appRoleId string) error {
+	properties := stable.AppRoleAssignment{
+		AppRoleId:   pointer.To(appRoleId),
+		PrincipalId: nullable.Value(principalId),
+		ResourceId:  nullable.Value(resourceId),
+	}
+
+	options := approleassignedto.CreateAppRoleAssignedToOperationOptions{
+		RetryFunc: func(resp *http.Response, o *odata.OData) (bool, error) {
+			if response.WasNotFound(resp) {
+				return true, nil
+			} else if response.WasBadRequest(resp) && o != nil && o.Error != nil {
+				return o.Error.Match("Not a valid reference update"), nil
+			}
+			return false, nil
+		},
+	}
+
+	if _, err := c.AppRoleAssignedToClient.CreateAppRoleAssignedTo(ctx, stable.NewServicePrincipalID(resourceId), properties, options); err != nil {
+		return fmt.Errorf("assigning app role %q for resource service principal with object ID %q: %+v", appRoleId, resourceId, err)
+	}
+
+	return nil
+}
+
+func applicationAdminConsentGrantScopes(ctx context.Context, c *client.Client, servicePrincipalId, resourceId string, grant stable.OAuth2PermissionGrant, grantExists bool, claimValues []string) error {
+	if grantExists {
+		id := stable.NewOAuth2PermissionGrantID(pointer.From(grant.Id))
+
+		if len(claimValues) == 0 {
+			if resp, err := c.OAuth2PermissionGrantClient.DeleteOAuth2PermissionGrant(ctx, id, oauth2permissiongrant.DefaultDeleteOAuth2PermissionGrantOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+			return nil
+		}
+
+		existing := tf.FromSpaceSeparated(grant.Scope.GetOrZero())
+		sort.Strings(existing)
+		if reflect.DeepEqual(existing, claimValues) {
+			return nil
+		}
+
+		properties := stable.OAuth2PermissionGrant{
+			Scope: nullable.NoZero(strings.Join(claimValues, " ")),
+		}
+
+		if _, err := c.OAuth2PermissionGrantClient.UpdateOAuth2PermissionGrant(ctx, id, properties, oauth2permissiongrant.DefaultUpdateOAuth2PermissionGrantOperationOptions()); err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+
+		return nil
+	}
+
+	if len(claimValues) == 0 {
+		return nil
+	}
+
+	properties := stable.OAuth2PermissionGrant{
+		ClientId:    pointer.To(servicePrincipalId),
+		ConsentType: nullable.Value(delegatedPermissionGrantConsentTypeAllPrincipals),
+		ResourceId:  pointer.To(resourceId),
+		Scope:       nullable.NoZero(strings.Join(claimValues, " ")),
+	}
+
+	options := oauth2permissiongrant.CreateOAuth2PermissionGrantOperationOptions{
+		RetryFunc: func(resp *http.Response, o *odata.OData) (bool, error) {
+			if response.WasNotFound(resp) {
+				return true, nil
+			} else if response.WasBadRequest(resp) && o != nil && o.Error != nil {
+				return o.Error.Match("does not exist or one of its queried reference-property objects are not present"), nil
+			}
+			return false, nil
+		},
+	}
+
+	if _, err := c.OAuth2PermissionGrantClient.CreateOAuth2PermissionGrant(ctx, properties, options); err != nil {
+		return fmt.Errorf("creating delegated permission grant for resource service principal with object ID %q: %+v", resourceId, err)
+	}
+
+	return nil
+}
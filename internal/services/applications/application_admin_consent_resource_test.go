@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/applications/parse"
+)
+
+type ApplicationAdminConsentResource struct{}
+
+func TestAccApplicationAdminConsent_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_admin_consent", "test")
+	r := ApplicationAdminConsentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_principal_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("consented_resource_access.#").HasValue("1"),
+				check.That(data.ResourceName).Key("consented_resource_access.0.role_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("consented_resource_access.0.scope_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("added_resource_access"),
+	})
+}
+
+func TestAccApplicationAdminConsent_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_admin_consent", "test")
+	r := ApplicationAdminConsentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("consented_resource_access.0.role_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("added_resource_access"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("consented_resource_access.0.role_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("consented_resource_access.0.scope_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("added_resource_access", "triggers"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("consented_resource_access.0.role_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("consented_resource_access.0.scope_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("added_resource_access"),
+	})
+}
+
+func TestAccApplicationAdminConsent_existingConsent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_admin_consent", "test")
+	r := ApplicationAdminConsentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.existingConsent(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("consented_resource_access.0.role_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("added_resource_access.0.role_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("added_resource_access.0.scope_ids.#").HasValue("1"),
+			),
+		},
+		{
+			// The app role assignment granted before admin consent was managed should not be revoked, which would
+			// otherwise cause a non-empty plan for the assignment resource
+			Config: r.existingConsent(data, false),
+		},
+	})
+}
+
+func (r ApplicationAdminConsentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Applications.ApplicationClient
+
+	id, err := parse.ParseAdminConsentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetApplication(ctx, stable.NewApplicationID(id.ApplicationId), application.DefaultGetApplicationOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (ApplicationAdminConsentResource) template(data acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+data "azuread_application_published_app_ids" "well_known" {}
+
+resource "azuread_service_principal" "msgraph" {
+  client_id    = data.azuread_application_published_app_ids.well_known.result.MicrosoftGraph
+  use_existing = true
+}
+`
+}
+
+func (r ApplicationAdminConsentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  display_name = "acctest-AppAdminConsent-%[2]d"
+
+  required_resource_access {
+    resource_app_id = data.azuread_application_published_app_ids.well_known.result.MicrosoftGraph
+
+    resource_access {
+      id   = azuread_service_principal.msgraph.app_role_ids["User.Read.All"]
+      type = "Role"
+    }
+
+    resource_access {
+      id   = azuread_service_principal.msgraph.oauth2_permission_scope_ids["User.Read"]
+      type = "Scope"
+    }
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_application_admin_consent" "test" {
+  application_id = azuread_application.test.id
+
+  depends_on = [azuread_service_principal.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationAdminConsentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  display_name = "acctest-AppAdminConsent-%[2]d"
+
+  required_resource_access {
+    resource_app_id = data.azuread_application_published_app_ids.well_known.result.MicrosoftGraph
+
+    resource_access {
+      id   = azuread_service_principal.msgraph.app_role_ids["User.Read.All"]
+      type = "Role"
+    }
+
+    resource_access {
+      id   = azuread_service_principal.msgraph.app_role_ids["Group.Read.All"]
+      type = "Role"
+    }
+
+    resource_access {
+      id   = azuread_service_principal.msgraph.oauth2_permission_scope_ids["User.Read"]
+      type = "Scope"
+    }
+
+    resource_access {
+      id   = azuread_service_principal.msgraph.oauth2_permission_scope_ids["openid"]
+      type = "Scope"
+    }
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_application_admin_consent" "test" {
+  application_id = azuread_application.test.id
+
+  triggers = {
+    required_resource_access = sha1(jsonencode(azuread_application.test.required_resource_access))
+  }
+
+  depends_on = [azuread_service_principal.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationAdminConsentResource) existingConsent(data acceptance.TestData, withAdminConsent bool) string {
+	adminConsent := ""
+	if withAdminConsent {
+		adminConsent = `
+resource "azuread_application_admin_consent" "test" {
+  application_id = azuread_application.test.id
+
+  depends_on = [azuread_app_role_assignment.existing]
+}
+`
+	}
+
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  display_name = "acctest-AppAdminConsent-%[2]d"
+
+  required_resource_access {
+    resource_app_id = data.azuread_application_published_app_ids.well_known.result.MicrosoftGraph
+
+    resource_access {
+      id   = azuread_service_principal.msgraph.app_role_ids["User.Read.All"]
+      type = "Role"
+    }
+
+    resource_access {
+      id   = azuread_service_principal.msgraph.oauth2_permission_scope_ids["User.Read"]
+      type = "Scope"
+    }
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_app_role_assignment" "existing" {
+  app_role_id         = azuread_service_principal.msgraph.app_role_ids["User.Read.All"]
+  principal_object_id = azuread_service_principal.test.object_id
+  resource_object_id  = azuread_service_principal.msgraph.object_id
+}
+%[3]s
+`, r.template(data), data.RandomInteger, adminConsent)
+}
@@ -413,6 +413,45 @@ func applicationDataSource() *pluginsdk.Resource {
 				Computed:    true,
 			},
 
+			"service_principal_lock": {
+				Description: "Configures locking of sensitive properties on service principals created from this application in other tenants",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Description: "Whether the service principal lock configuration is enabled",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+
+						"all_properties": {
+							Description: "Whether all sensitive properties are locked",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+
+						"credentials_with_usage_sign": {
+							Description: "Whether key and password credentials with a usage type of `Sign` are locked",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+
+						"credentials_with_usage_verify": {
+							Description: "Whether key and password credentials with a usage type of `Verify` are locked",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+
+						"token_encryption_key_id": {
+							Description: "Whether the `tokenEncryptionKeyId` property is locked",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
 			"sign_in_audience": {
 				Description: "The Microsoft account types that are supported for the current application",
 				Type:        pluginsdk.TypeString,
@@ -457,6 +496,33 @@ func applicationDataSource() *pluginsdk.Resource {
 				Computed:    true,
 			},
 
+			"verified_publisher": {
+				Description: "The verified publisher for this application",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"verified_publisher_id": {
+							Description: "The Microsoft Partner Network ID (MPN ID) of the verified publisher",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The verified publisher name from the publisher's Partner Center account",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"added_date_time": {
+							Description: "The timestamp when the verified publisher was first added or most recently updated",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
 			"web": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -604,9 +670,11 @@ func applicationDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, m
 	tf.Set(d, "publisher_domain", app.PublisherDomain.GetOrZero())
 	tf.Set(d, "required_resource_access", flattenApplicationRequiredResourceAccess(app.RequiredResourceAccess))
 	tf.Set(d, "service_management_reference", app.ServiceManagementReference.GetOrZero())
+	tf.Set(d, "service_principal_lock", flattenApplicationServicePrincipalLockConfiguration(app.ServicePrincipalLockConfiguration))
 	tf.Set(d, "sign_in_audience", app.SignInAudience.GetOrZero())
 	tf.Set(d, "single_page_application", flattenApplicationSpa(app.Spa))
 	tf.Set(d, "tags", tf.FlattenStringSlicePtr(app.Tags))
+	tf.Set(d, "verified_publisher", flattenApplicationVerifiedPublisher(app.VerifiedPublisher))
 	tf.Set(d, "web", flattenApplicationWeb(app.Web))
 
 	if app.Api != nil {
@@ -7,6 +7,7 @@ package applications
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/federatedidentitycredential"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
@@ -22,6 +24,7 @@ import (
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
 	"github.com/valiparsa/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/applications/validate"
 )
 
 func applicationFederatedIdentityCredentialResource() *pluginsdk.Resource {
@@ -43,6 +46,17 @@ func applicationFederatedIdentityCredentialResource() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			applicationFederatedIdentityCredentialResourcePresetCustomizeDiff,
+			applicationFederatedIdentityCredentialResourceCustomizeDiff,
+
+			// The API does not support unsetting either property, so switching between a subject and an expression
+			// requires the credential to be replaced
+			pluginsdk.ForceNewIfChange("claims_matching_expression", func(ctx context.Context, old, new, meta interface{}) bool {
+				return (old.(string) == "") != (new.(string) == "")
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"application_id": {
 				Description:  "The resource ID of the application for which this federated identity credential should be created",
@@ -55,7 +69,8 @@ func applicationFederatedIdentityCredentialResource() *pluginsdk.Resource {
 			"audiences": {
 				Description: "List of audiences that can appear in the external token. This specifies what should be accepted in the `aud` claim of incoming tokens.",
 				Type:        pluginsdk.TypeList,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				MaxItems:    1,
 				// TODO: consider making this a scalar value instead of a list in v3.0 (the API now only accepts a single value)
 				Elem: &pluginsdk.Schema{
@@ -73,17 +88,35 @@ func applicationFederatedIdentityCredentialResource() *pluginsdk.Resource {
 			},
 
 			"issuer": {
-				Description: "The URL of the external identity provider, which must match the issuer claim of the external token being exchanged. The combination of the values of issuer and subject must be unique on the app.",
-				Type:        pluginsdk.TypeString,
-				Required:    true,
+				Description:  "The URL of the external identity provider, which must match the issuer claim of the external token being exchanged. The combination of the values of issuer and subject must be unique on the app.",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: append([]string{"issuer"}, federatedIdentityCredentialPresetBlockNames()...),
 			},
 
 			"subject": {
-				Description: "The identifier of the external software workload within the external identity provider. The combination of issuer and subject must be unique on the app.",
-				Type:        pluginsdk.TypeString,
-				Required:    true,
+				Description:   "The identifier of the external software workload within the external identity provider. The combination of issuer and subject must be unique on the app.",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"claims_matching_expression"},
+			},
+
+			"claims_matching_expression": {
+				Description:      "A claims matching expression used to match incoming tokens, for example `claims['sub'] matches 'repo:contoso/contoso-repo:ref:refs/heads/*'`",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"subject"},
+				ValidateDiagFunc: validate.ClaimsMatchingExpression,
 			},
 
+			"github": applicationFederatedIdentityCredentialPresetSchema("github"),
+
+			"kubernetes": applicationFederatedIdentityCredentialPresetSchema("kubernetes"),
+
+			"terraform_cloud": applicationFederatedIdentityCredentialPresetSchema("terraform_cloud"),
+
 			"description": {
 				Description: "A description for the federated identity credential",
 				Type:        pluginsdk.TypeString,
@@ -99,9 +132,149 @@ func applicationFederatedIdentityCredentialResource() *pluginsdk.Resource {
 	}
 }
 
+func applicationFederatedIdentityCredentialPresetSchema(name string) *pluginsdk.Schema {
+	preset := federatedIdentityCredentialPresetBlocks[name]
+
+	return &pluginsdk.Schema{
+		Description:  preset.Description,
+		Type:         pluginsdk.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: append([]string{"issuer"}, federatedIdentityCredentialPresetBlockNames()...),
+		Elem: &pluginsdk.Resource{
+			Schema: preset.Schema,
+		},
+	}
+}
+
+// applicationFederatedIdentityCredentialResourcePresetCustomizeDiff populates the `issuer`, `subject` and `audiences`
+// from a preset block, and ensures that a subject or claims matching expression is present
+func applicationFederatedIdentityCredentialResourcePresetCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	subjectConfigured := !config.GetAttr("subject").IsNull()
+	expressionConfigured := !config.GetAttr("claims_matching_expression").IsNull()
+
+	presetName := ""
+	for _, name := range federatedIdentityCredentialPresetBlockNames() {
+		if len(diff.Get(name).([]interface{})) > 0 {
+			presetName = name
+		}
+	}
+
+	if presetName == "" {
+		if config.GetAttr("audiences").IsNull() {
+			return fmt.Errorf("`audiences` must be specified when `issuer` is specified")
+		}
+		if !subjectConfigured && !expressionConfigured {
+			return fmt.Errorf("one of `subject` or `claims_matching_expression` must be specified")
+		}
+		if !subjectConfigured && diff.Get("subject").(string) != "" {
+			return diff.SetNew("subject", "")
+		}
+		return nil
+	}
+
+	if subjectConfigured {
+		return fmt.Errorf("`subject` cannot be specified with the `%s` block", presetName)
+	}
+
+	if config.GetAttr("audiences").IsNull() {
+		if err := diff.SetNew("audiences", []interface{}{federatedIdentityCredentialDefaultAudience}); err != nil {
+			return err
+		}
+	}
+
+	if !diff.NewValueKnown(presetName) {
+		if err := diff.SetNewComputed("issuer"); err != nil {
+			return err
+		}
+		if !expressionConfigured {
+			return diff.SetNewComputed("subject")
+		}
+		return nil
+	}
+
+	block, ok := diff.Get(presetName).([]interface{})[0].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("the `%s` block must not be empty", presetName)
+	}
+
+	issuer, subject, err := federatedIdentityCredentialPresetBlocks[presetName].Expand(block)
+	if err != nil {
+		return fmt.Errorf("expanding `%s`: %+v", presetName, err)
+	}
+
+	switch {
+	case subject != "" && expressionConfigured:
+		return fmt.Errorf("`claims_matching_expression` cannot be specified when the `%s` block identifies a workload", presetName)
+	case subject == "" && !expressionConfigured:
+		return fmt.Errorf("`claims_matching_expression` must be specified when the `%s` block does not identify a workload", presetName)
+	}
+
+	if err = diff.SetNew("issuer", issuer); err != nil {
+		return err
+	}
+
+	return diff.SetNew("subject", subject)
+}
+
+func applicationFederatedIdentityCredentialResourceCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("issuer") {
+		return nil
+	}
+
+	preset := federatedIdentityCredentialPresetForIssuer(diff.Get("issuer").(string))
+	if preset == nil {
+		return nil
+	}
+
+	if diff.NewValueKnown("subject") {
+		if subject := diff.Get("subject").(string); subject != "" {
+			if err := preset.ValidateSubject(subject); err != nil {
+				return fmt.Errorf("validating `subject`: %+v", err)
+			}
+		}
+	}
+
+	if diff.NewValueKnown("claims_matching_expression") {
+		if expression := diff.Get("claims_matching_expression").(string); expression != "" {
+			if err := preset.ValidateClaimsMatchingExpression(expression); err != nil {
+				return fmt.Errorf("validating `claims_matching_expression`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// expandFederatedIdentityCredentialClaimsMatchingExpression returns a language version 1 expression, or nil when the
+// expression is empty
+func expandFederatedIdentityCredentialClaimsMatchingExpression(in string) *beta.FederatedIdentityExpression {
+	if in == "" {
+		return nil
+	}
+
+	return &beta.FederatedIdentityExpression{
+		LanguageVersion: 1,
+		Value:           in,
+	}
+}
+
+func flattenFederatedIdentityCredentialClaimsMatchingExpression(in *beta.FederatedIdentityExpression) string {
+	if in == nil {
+		return ""
+	}
+
+	return in.Value
+}
+
 func applicationFederatedIdentityCredentialResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics { //nolint
 	client := meta.(*clients.Client).Applications.ApplicationClient
-	federatedIdentityCredentialClient := meta.(*clients.Client).Applications.ApplicationFederatedIdentityCredential
+	federatedIdentityCredentialClient := meta.(*clients.Client).Applications.ApplicationFederatedIdentityCredentialBeta
 
 	applicationId, err := stable.ParseApplicationID(d.Get("application_id").(string))
 	if err != nil {
@@ -121,15 +294,16 @@ func applicationFederatedIdentityCredentialResourceCreate(ctx context.Context, d
 		return tf.ErrorDiagF(errors.New("model was nil"), "retrieving %s", applicationId)
 	}
 
-	credential := stable.FederatedIdentityCredential{
-		Audiences:   tf.ExpandStringSlice(d.Get("audiences").([]interface{})),
-		Description: nullable.Value(d.Get("description").(string)),
-		Issuer:      d.Get("issuer").(string),
-		Name:        d.Get("display_name").(string),
-		Subject:     d.Get("subject").(string),
+	credential := beta.FederatedIdentityCredential{
+		Audiences:                tf.ExpandStringSlice(d.Get("audiences").([]interface{})),
+		ClaimsMatchingExpression: expandFederatedIdentityCredentialClaimsMatchingExpression(d.Get("claims_matching_expression").(string)),
+		Description:              nullable.Value(d.Get("description").(string)),
+		Issuer:                   d.Get("issuer").(string),
+		Name:                     d.Get("display_name").(string),
+		Subject:                  nullable.NoZero(d.Get("subject").(string)),
 	}
 
-	federatedIdentityCredentialResp, err := federatedIdentityCredentialClient.CreateFederatedIdentityCredential(ctx, *applicationId, credential, federatedidentitycredential.DefaultCreateFederatedIdentityCredentialOperationOptions())
+	federatedIdentityCredentialResp, err := federatedIdentityCredentialClient.CreateFederatedIdentityCredential(ctx, beta.NewApplicationID(applicationId.ApplicationId), credential)
 	if err != nil {
 		return tf.ErrorDiagF(err, "Adding federated identity credential for %s", applicationId)
 	}
@@ -142,7 +316,7 @@ func applicationFederatedIdentityCredentialResourceCreate(ctx context.Context, d
 		return tf.ErrorDiagF(errors.New("nil or empty ID received"), "API error adding federated identity credential for %s", applicationId)
 	}
 
-	id := stable.NewApplicationIdFederatedIdentityCredentialID(applicationId.ApplicationId, *newCredential.Id)
+	id := beta.NewApplicationIdFederatedIdentityCredentialID(applicationId.ApplicationId, *newCredential.Id)
 
 	// Wait for the credential to replicate
 	timeout, _ := ctx.Deadline()
@@ -153,7 +327,7 @@ func applicationFederatedIdentityCredentialResourceCreate(ctx context.Context, d
 		MinTimeout:                1 * time.Second,
 		ContinuousTargetOccurence: 5,
 		Refresh: func() (interface{}, string, error) {
-			resp, err := federatedIdentityCredentialClient.GetFederatedIdentityCredential(ctx, id, federatedidentitycredential.DefaultGetFederatedIdentityCredentialOperationOptions())
+			resp, err := federatedIdentityCredentialClient.GetFederatedIdentityCredential(ctx, id)
 			if err != nil {
 				if response.WasNotFound(resp.HttpResponse) {
 					return nil, "Waiting", nil
@@ -183,7 +357,7 @@ func applicationFederatedIdentityCredentialResourceCreate(ctx context.Context, d
 }
 
 func applicationFederatedIdentityCredentialResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics { //nolint
-	federatedIdentityCredentialClient := meta.(*clients.Client).Applications.ApplicationFederatedIdentityCredential
+	federatedIdentityCredentialClient := meta.(*clients.Client).Applications.ApplicationFederatedIdentityCredentialBeta
 
 	id, err := parse.FederatedIdentityCredentialID(d.Id())
 	if err != nil {
@@ -193,20 +367,21 @@ func applicationFederatedIdentityCredentialResourceUpdate(ctx context.Context, d
 	tf.LockByName(applicationResourceName, id.ObjectId)
 	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
 
-	credential := stable.FederatedIdentityCredential{
-		Id:          pointer.To(id.KeyId),
-		Audiences:   tf.ExpandStringSlice(d.Get("audiences").([]interface{})),
-		Description: nullable.Value(d.Get("description").(string)),
-		Issuer:      d.Get("issuer").(string),
-		Subject:     d.Get("subject").(string),
+	credential := beta.FederatedIdentityCredential{
+		Id:                       pointer.To(id.KeyId),
+		Audiences:                tf.ExpandStringSlice(d.Get("audiences").([]interface{})),
+		ClaimsMatchingExpression: expandFederatedIdentityCredentialClaimsMatchingExpression(d.Get("claims_matching_expression").(string)),
+		Description:              nullable.Value(d.Get("description").(string)),
+		Issuer:                   d.Get("issuer").(string),
+		Subject:                  nullable.NoZero(d.Get("subject").(string)),
 
 		// Name is immutable but must be specified as it is a required field
 		Name: d.Get("display_name").(string),
 	}
 
-	credentialId := stable.NewApplicationIdFederatedIdentityCredentialID(id.ObjectId, id.KeyId)
+	credentialId := beta.NewApplicationIdFederatedIdentityCredentialID(id.ObjectId, id.KeyId)
 
-	if _, err = federatedIdentityCredentialClient.UpdateFederatedIdentityCredential(ctx, credentialId, credential, federatedidentitycredential.DefaultUpdateFederatedIdentityCredentialOperationOptions()); err != nil {
+	if _, err = federatedIdentityCredentialClient.UpdateFederatedIdentityCredential(ctx, credentialId, credential); err != nil {
 		return tf.ErrorDiagF(err, "Updating federated identity credential with ID %q for application with object ID %q", id.KeyId, id.ObjectId)
 	}
 
@@ -214,7 +389,7 @@ func applicationFederatedIdentityCredentialResourceUpdate(ctx context.Context, d
 }
 
 func applicationFederatedIdentityCredentialResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics { //nolint
-	federatedIdentityCredentialClient := meta.(*clients.Client).Applications.ApplicationFederatedIdentityCredential
+	federatedIdentityCredentialClient := meta.(*clients.Client).Applications.ApplicationFederatedIdentityCredentialBeta
 
 	id, err := parse.FederatedIdentityCredentialID(d.Id())
 	if err != nil {
@@ -222,9 +397,9 @@ func applicationFederatedIdentityCredentialResourceRead(ctx context.Context, d *
 	}
 
 	applicationId := stable.NewApplicationID(id.ObjectId)
-	credentialId := stable.NewApplicationIdFederatedIdentityCredentialID(id.ObjectId, id.KeyId)
+	credentialId := beta.NewApplicationIdFederatedIdentityCredentialID(id.ObjectId, id.KeyId)
 
-	resp, err := federatedIdentityCredentialClient.GetFederatedIdentityCredential(ctx, credentialId, federatedidentitycredential.DefaultGetFederatedIdentityCredentialOperationOptions())
+	resp, err := federatedIdentityCredentialClient.GetFederatedIdentityCredential(ctx, credentialId)
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] Federated Identity Credential with ID %q for Application %s was not found - removing from state!", id.KeyId, id.ObjectId)
@@ -243,10 +418,23 @@ func applicationFederatedIdentityCredentialResourceRead(ctx context.Context, d *
 	tf.Set(d, "credential_id", id.KeyId)
 
 	tf.Set(d, "audiences", tf.FlattenStringSlice(credential.Audiences))
+	tf.Set(d, "claims_matching_expression", flattenFederatedIdentityCredentialClaimsMatchingExpression(credential.ClaimsMatchingExpression))
 	tf.Set(d, "description", credential.Description.GetOrZero())
 	tf.Set(d, "display_name", credential.Name)
 	tf.Set(d, "issuer", credential.Issuer)
-	tf.Set(d, "subject", credential.Subject)
+	tf.Set(d, "subject", credential.Subject.GetOrZero())
+
+	// Preset blocks are only populated when already present, since an imported credential may not have been created with one
+	for _, name := range federatedIdentityCredentialPresetBlockNames() {
+		if existing := d.Get(name).([]interface{}); len(existing) > 0 {
+			existingBlock, _ := existing[0].(map[string]interface{})
+			presetBlock := make([]interface{}, 0)
+			if v := federatedIdentityCredentialPresetBlocks[name].Flatten(credential.Issuer, credential.Subject.GetOrZero(), existingBlock); v != nil {
+				presetBlock = append(presetBlock, v)
+			}
+			tf.Set(d, name, presetBlock)
+		}
+	}
 
 	return nil
 }
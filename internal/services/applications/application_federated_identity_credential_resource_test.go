@@ -86,6 +86,74 @@ func TestAccApplicationFederatedIdentityCredential_update(t *testing.T) {
 	})
 }
 
+func TestAccApplicationFederatedIdentityCredential_claimsMatchingExpression(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_federated_identity_credential", "test")
+	r := ApplicationFederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.claimsMatchingExpression(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential_id").Exists(),
+				check.That(data.ResourceName).Key("subject").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claims_matching_expression").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationFederatedIdentityCredential_presets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_federated_identity_credential", "test")
+	r := ApplicationFederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.gitHubPreset(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("issuer").HasValue("https://token.actions.githubusercontent.com"),
+				check.That(data.ResourceName).Key("subject").HasValue(fmt.Sprintf("repo:hashitown/acctest-%s:environment:prod", data.RandomString)),
+				check.That(data.ResourceName).Key("audiences.0").HasValue("api://AzureADTokenExchange"),
+			),
+		},
+		data.ImportStep("github"),
+		{
+			Config: r.gitHubPresetWithExpression(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subject").IsEmpty(),
+			),
+		},
+		data.ImportStep("github"),
+		{
+			Config: r.terraformCloudPreset(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("issuer").HasValue("https://app.terraform.io"),
+				check.That(data.ResourceName).Key("subject").HasValue(fmt.Sprintf("organization:hashitown:project:acctest:workspace:acctest-%s:run_phase:apply", data.RandomString)),
+			),
+		},
+		data.ImportStep("terraform_cloud"),
+		{
+			Config: r.kubernetesPreset(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subject").HasValue("system:serviceaccount:acctest:workload"),
+			),
+		},
+		data.ImportStep("kubernetes"),
+	})
+}
+
 func (r ApplicationFederatedIdentityCredentialResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Applications.ApplicationFederatedIdentityCredential
 
@@ -143,3 +211,87 @@ resource "azuread_application_federated_identity_credential" "test" {
 }
 `, r.template(data), data.RandomString, data.UUID())
 }
+
+func (r ApplicationFederatedIdentityCredentialResource) claimsMatchingExpression(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_id             = azuread_application.test.id
+  display_name               = "hashitown.example.com-%[2]s"
+  audiences                  = ["api://AzureADTokenExchange"]
+  issuer                     = "https://token.actions.githubusercontent.com"
+  claims_matching_expression = "claims['sub'] matches 'repo:hashitown/acctest-%[2]s:ref:refs/heads/*'"
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r ApplicationFederatedIdentityCredentialResource) gitHubPreset(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_id = azuread_application.test.id
+  display_name   = "hashitown.example.com-%[2]s"
+
+  github {
+    organization = "hashitown"
+    repository   = "acctest-%[2]s"
+    entity_type  = "environment"
+    value        = "prod"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r ApplicationFederatedIdentityCredentialResource) gitHubPresetWithExpression(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_id             = azuread_application.test.id
+  display_name               = "hashitown.example.com-%[2]s"
+  claims_matching_expression = "claims['sub'] matches 'repo:hashitown/acctest-%[2]s:ref:refs/heads/*'"
+
+  github {
+    organization = "hashitown"
+    repository   = "acctest-%[2]s"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r ApplicationFederatedIdentityCredentialResource) terraformCloudPreset(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_id = azuread_application.test.id
+  display_name   = "hashitown.example.com-%[2]s"
+
+  terraform_cloud {
+    organization = "hashitown"
+    project      = "acctest"
+    workspace    = "acctest-%[2]s"
+    run_phase    = "apply"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r ApplicationFederatedIdentityCredentialResource) kubernetesPreset(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_id = azuread_application.test.id
+  display_name   = "hashitown.example.com-%[2]s"
+
+  kubernetes {
+    oidc_issuer_url      = "https://westeurope.oic.prod-aks.azure.com/00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/"
+    namespace            = "acctest"
+    service_account_name = "workload"
+  }
+}
+`, r.template(data), data.RandomString)
+}
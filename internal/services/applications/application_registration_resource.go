@@ -23,28 +23,40 @@ import (
 )
 
 type ApplicationRegistrationModel struct {
-	ClientId                           string   `tfschema:"client_id"`
-	Description                        string   `tfschema:"description"`
-	DisabledByMicrosoft                string   `tfschema:"disabled_by_microsoft"`
-	DisplayName                        string   `tfschema:"display_name"`
-	GroupMembershipClaims              []string `tfschema:"group_membership_claims"`
-	HomepageUrl                        string   `tfschema:"homepage_url"`
-	ImplicitAccessTokenIssuanceEnabled bool     `tfschema:"implicit_access_token_issuance_enabled"`
-	ImplicitIdTokenIssuanceEnabled     bool     `tfschema:"implicit_id_token_issuance_enabled"`
-	LogoutUrl                          string   `tfschema:"logout_url"`
-	MarketingUrl                       string   `tfschema:"marketing_url"`
-	Notes                              string   `tfschema:"notes"`
-	ObjectId                           string   `tfschema:"object_id"`
-	PrivacyStatementUrl                string   `tfschema:"privacy_statement_url"`
-	PublisherDomain                    string   `tfschema:"publisher_domain"`
-	RequestedAccessTokenVersion        int      `tfschema:"requested_access_token_version"`
-	ServiceManagementReference         string   `tfschema:"service_management_reference"`
-	SignInAudience                     string   `tfschema:"sign_in_audience"`
-	SupportUrl                         string   `tfschema:"support_url"`
-	TermsOfServiceUrl                  string   `tfschema:"terms_of_service_url"`
+	ClientId                           string                                 `tfschema:"client_id"`
+	Description                        string                                 `tfschema:"description"`
+	DisabledByMicrosoft                string                                 `tfschema:"disabled_by_microsoft"`
+	DisplayName                        string                                 `tfschema:"display_name"`
+	GroupMembershipClaims              []string                               `tfschema:"group_membership_claims"`
+	HomepageUrl                        string                                 `tfschema:"homepage_url"`
+	ImplicitAccessTokenIssuanceEnabled bool                                   `tfschema:"implicit_access_token_issuance_enabled"`
+	ImplicitIdTokenIssuanceEnabled     bool                                   `tfschema:"implicit_id_token_issuance_enabled"`
+	LogoutUrl                          string                                 `tfschema:"logout_url"`
+	MarketingUrl                       string                                 `tfschema:"marketing_url"`
+	Notes                              string                                 `tfschema:"notes"`
+	ObjectId                           string                                 `tfschema:"object_id"`
+	PrivacyStatementUrl                string                                 `tfschema:"privacy_statement_url"`
+	PublisherDomain                    string                                 `tfschema:"publisher_domain"`
+	RequestedAccessTokenVersion        int                                    `tfschema:"requested_access_token_version"`
+	ServiceManagementReference         string                                 `tfschema:"service_management_reference"`
+	ServicePrincipalLock               []ApplicationServicePrincipalLockModel `tfschema:"service_principal_lock"`
+	SignInAudience                     string                                 `tfschema:"sign_in_audience"`
+	SupportUrl                         string                                 `tfschema:"support_url"`
+	TermsOfServiceUrl                  string                                 `tfschema:"terms_of_service_url"`
 }
 
-var _ sdk.ResourceWithUpdate = ApplicationRegistrationResource{}
+type ApplicationServicePrincipalLockModel struct {
+	Enabled                    bool `tfschema:"enabled"`
+	AllProperties              bool `tfschema:"all_properties"`
+	CredentialsWithUsageSign   bool `tfschema:"credentials_with_usage_sign"`
+	CredentialsWithUsageVerify bool `tfschema:"credentials_with_usage_verify"`
+	TokenEncryptionKeyId       bool `tfschema:"token_encryption_key_id"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate         = ApplicationRegistrationResource{}
+	_ sdk.ResourceWithCustomImporter = ApplicationRegistrationResource{}
+)
 
 type ApplicationRegistrationResource struct{}
 
@@ -148,6 +160,8 @@ func (r ApplicationRegistrationResource) Arguments() map[string]*pluginsdk.Schem
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 
+		"service_principal_lock": schemaServicePrincipalLock(),
+
 		"sign_in_audience": {
 			Description:  "The Microsoft account types that are supported for the current application",
 			Type:         pluginsdk.TypeString,
@@ -241,6 +255,11 @@ func (r ApplicationRegistrationResource) Create() sdk.ResourceFunc {
 				},
 			}
 
+			// Leave the tenant default lock configuration in place unless one is specified
+			if len(model.ServicePrincipalLock) > 0 {
+				properties.ServicePrincipalLockConfiguration = expandApplicationServicePrincipalLockModel(model.ServicePrincipalLock)
+			}
+
 			resp, err := client.CreateApplication(ctx, properties, application.DefaultCreateApplicationOperationOptions())
 			if err != nil {
 				return fmt.Errorf("creating application: %+v", err)
@@ -320,6 +339,15 @@ func (r ApplicationRegistrationResource) Read() sdk.ResourceFunc {
 				state.DisabledByMicrosoft = fmt.Sprintf("%v", app.DisabledByMicrosoftStatus)
 			}
 
+			// The lock configuration is only tracked once managed, so that the tenant default lock is not reported as drift
+			var existing ApplicationRegistrationModel
+			if err = metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			if len(existing.ServicePrincipalLock) > 0 {
+				state.ServicePrincipalLock = flattenApplicationServicePrincipalLockModel(app.ServicePrincipalLockConfiguration)
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -377,6 +405,10 @@ func (r ApplicationRegistrationResource) Update() sdk.ResourceFunc {
 				properties.SignInAudience = nullable.Value(model.SignInAudience)
 			}
 
+			if rd.HasChange("service_principal_lock") {
+				properties.ServicePrincipalLockConfiguration = expandApplicationServicePrincipalLockModel(model.ServicePrincipalLock)
+			}
+
 			if rd.HasChange("marketing_url") || rd.HasChange("privacy_statement_url") || rd.HasChange("support_url") || rd.HasChange("terms_of_service_url") {
 				properties.Info = &stable.InformationalUrl{}
 
@@ -430,6 +462,19 @@ func (r ApplicationRegistrationResource) Update() sdk.ResourceFunc {
 	}
 }
 
+func (r ApplicationRegistrationResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		client := metadata.Client.Applications.ApplicationClient
+
+		id, err := stable.ParseApplicationID(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+
+		return importApplicationServicePrincipalLock(ctx, client, *id, metadata.ResourceData)
+	}
+}
+
 func (r ApplicationRegistrationResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -462,3 +507,36 @@ func (r ApplicationRegistrationResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+// expandApplicationServicePrincipalLockModel adapts the model to expandApplicationServicePrincipalLockConfiguration
+func expandApplicationServicePrincipalLockModel(input []ApplicationServicePrincipalLockModel) *stable.ServicePrincipalLockConfiguration {
+	lock := make([]interface{}, 0)
+	for _, v := range input {
+		lock = append(lock, map[string]interface{}{
+			"enabled":                       v.Enabled,
+			"all_properties":                v.AllProperties,
+			"credentials_with_usage_sign":   v.CredentialsWithUsageSign,
+			"credentials_with_usage_verify": v.CredentialsWithUsageVerify,
+			"token_encryption_key_id":       v.TokenEncryptionKeyId,
+		})
+	}
+
+	return expandApplicationServicePrincipalLockConfiguration(lock)
+}
+
+// flattenApplicationServicePrincipalLockModel adapts the result of flattenApplicationServicePrincipalLockConfiguration
+// to the model
+func flattenApplicationServicePrincipalLockModel(input *stable.ServicePrincipalLockConfiguration) []ApplicationServicePrincipalLockModel {
+	result := make([]ApplicationServicePrincipalLockModel, 0)
+	for _, v := range flattenApplicationServicePrincipalLockConfiguration(input) {
+		result = append(result, ApplicationServicePrincipalLockModel{
+			Enabled:                    v["enabled"].(bool),
+			AllProperties:              v["all_properties"].(bool),
+			CredentialsWithUsageSign:   v["credentials_with_usage_sign"].(bool),
+			CredentialsWithUsageVerify: v["credentials_with_usage_verify"].(bool),
+			TokenEncryptionKeyId:       v["token_encryption_key_id"].(bool),
+		})
+	}
+
+	return result
+}
@@ -142,6 +142,13 @@ resource "azuread_application_registration" "test" {
   privacy_statement_url = "https://hashitown.example.com-%[1]d.com/privacy"
   support_url           = "https://support.hashitown.example.com-%[1]d.com/"
   terms_of_service_url  = "https://hashitown.example.com-%[1]d.com/terms"
+
+  service_principal_lock {
+    enabled                       = true
+    credentials_with_usage_sign   = true
+    credentials_with_usage_verify = true
+    token_encryption_key_id       = true
+  }
 }
 `, data.RandomInteger)
 }
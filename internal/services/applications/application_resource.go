@@ -54,7 +54,7 @@ func applicationResource() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			if _, errs := stable.ValidateApplicationID(id, "id"); len(errs) > 0 {
 				out := ""
 				for _, err := range errs {
@@ -63,6 +63,17 @@ func applicationResource() *pluginsdk.Resource {
 				return errors.New(out)
 			}
 			return nil
+		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			id, err := stable.ParseApplicationID(d.Id())
+			if err != nil {
+				return nil, err
+			}
+
+			if err = importApplicationServicePrincipalLock(ctx, meta.(*clients.Client).Applications.ApplicationClient, *id, d); err != nil {
+				return nil, err
+			}
+
+			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
 		SchemaVersion: 2,
@@ -522,6 +533,8 @@ func applicationResource() *pluginsdk.Resource {
 				Optional:    true,
 			},
 
+			"service_principal_lock": schemaServicePrincipalLock(),
+
 			"sign_in_audience": {
 				Description:  "The Microsoft account types that are supported for the current application",
 				Type:         pluginsdk.TypeString,
@@ -1162,6 +1175,11 @@ func applicationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, m
 		Web:                        expandApplicationWeb(d.Get("web").([]interface{})),
 	}
 
+	// Leave the tenant default lock configuration in place unless one is specified
+	if v, ok := d.GetOk("service_principal_lock"); ok {
+		properties.ServicePrincipalLockConfiguration = expandApplicationServicePrincipalLockConfiguration(v.([]interface{}))
+	}
+
 	// Generate an application password, if specified
 	if v, ok := d.GetOk("password"); ok {
 		password := v.(*pluginsdk.Set).List()
@@ -1505,6 +1523,10 @@ func applicationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, m
 		Web:                        expandApplicationWeb(d.Get("web").([]interface{})),
 	}
 
+	if d.HasChange("service_principal_lock") {
+		properties.ServicePrincipalLockConfiguration = expandApplicationServicePrincipalLockConfiguration(d.Get("service_principal_lock").([]interface{}))
+	}
+
 	api := expandApplicationApi(d.Get("api").([]interface{}))
 
 	if d.HasChange("app_role") {
@@ -1649,6 +1671,11 @@ func applicationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, met
 	tf.Set(d, "template_id", app.ApplicationTemplateId.GetOrZero())
 	tf.Set(d, "web", flattenApplicationWeb(app.Web))
 
+	// The lock configuration is only tracked once managed, so that the tenant default lock is not reported as drift
+	if len(d.Get("service_principal_lock").([]interface{})) > 0 {
+		tf.Set(d, "service_principal_lock", flattenApplicationServicePrincipalLockConfiguration(app.ServicePrincipalLockConfiguration))
+	}
+
 	if app.Api != nil {
 		tf.Set(d, "oauth2_permission_scope_ids", applications.FlattenOAuth2PermissionScopeIDs(app.Api.OAuth2PermissionScopes))
 	}
@@ -573,6 +573,47 @@ func TestAccApplication_featureTagsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccApplication_servicePrincipalLock(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.servicePrincipalLock(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_principal_lock.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("service_principal_lock.0.all_properties").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.servicePrincipalLock(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_principal_lock.0.enabled").HasValue("false"),
+			),
+		},
+		// A disabled lock is equivalent to omitting the block, so it is not populated on import
+		data.ImportStep("service_principal_lock"),
+		{
+			Config: r.servicePrincipalLock(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_principal_lock.0.enabled").HasValue("true"),
+			),
+		},
+		{
+			Config: r.servicePrincipalLockRemoved(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_principal_lock.#").HasValue("0"),
+				check.That("data.azuread_application.test").Key("service_principal_lock.0.enabled").HasValue("false"),
+			),
+		},
+	})
+}
+
 func TestAccApplication_logo(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
@@ -1704,6 +1745,39 @@ resource "azuread_application" "test" {
 `, data.RandomInteger)
 }
 
+func (r ApplicationResource) servicePrincipalLock(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name     = "acctest-APP-%[1]d"
+  sign_in_audience = "AzureADMultipleOrgs"
+
+  service_principal_lock {
+    enabled        = %[2]t
+    all_properties = true
+  }
+}
+`, data.RandomInteger, enabled)
+}
+
+func (r ApplicationResource) servicePrincipalLockRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name     = "acctest-APP-%[1]d"
+  sign_in_audience = "AzureADMultipleOrgs"
+}
+
+data "azuread_application" "test" {
+  object_id = azuread_application.test.object_id
+
+  depends_on = [azuread_application.test]
+}
+`, data.RandomInteger)
+}
+
 func (r ApplicationResource) logo(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/valiparsa/terraform-provider-azuread/internal/sdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/applications/parse"
+)
+
+type ApplicationVerifiedPublisherModel struct {
+	ApplicationId       string `tfschema:"application_id"`
+	VerifiedPublisherId string `tfschema:"verified_publisher_id"`
+	DisplayName         string `tfschema:"display_name"`
+	AddedDateTime       string `tfschema:"added_date_time"`
+}
+
+var _ sdk.ResourceWithUpdate = ApplicationVerifiedPublisherResource{}
+
+type ApplicationVerifiedPublisherResource struct{}
+
+func (r ApplicationVerifiedPublisherResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateVerifiedPublisherID
+}
+
+func (r ApplicationVerifiedPublisherResource) ResourceType() string {
+	return "azuread_application_verified_publisher"
+}
+
+func (r ApplicationVerifiedPublisherResource) ModelObject() interface{} {
+	return &ApplicationVerifiedPublisherModel{}
+}
+
+func (r ApplicationVerifiedPublisherResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"application_id": {
+			Description:  "The resource ID of the application for which the verified publisher should be set",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: stable.ValidateApplicationID,
+		},
+
+		"verified_publisher_id": {
+			Description:  "The Microsoft Partner Network ID (MPN ID) of the verified publisher",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ApplicationVerifiedPublisherResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Description: "The verified publisher name from the publisher's Partner Center account",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"added_date_time": {
+			Description: "The timestamp when the verified publisher was first added or most recently updated",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r ApplicationVerifiedPublisherResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationClient
+
+			var model ApplicationVerifiedPublisherModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			applicationId, err := stable.ParseApplicationID(model.ApplicationId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewVerifiedPublisherID(applicationId.ApplicationId)
+
+			tf.LockByName(applicationResourceName, id.ApplicationId)
+			defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+			resp, err := client.GetApplication(ctx, *applicationId, application.DefaultGetApplicationOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", applicationId)
+				}
+				return fmt.Errorf("retrieving %s: %+v", applicationId, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", applicationId)
+			}
+
+			if vp := resp.Model.VerifiedPublisher; vp != nil && vp.VerifiedPublisherId.GetOrZero() != "" {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			request := application.SetVerifiedPublisherRequest{
+				VerifiedPublisherId: pointer.To(model.VerifiedPublisherId),
+			}
+
+			if _, err = client.SetVerifiedPublisher(ctx, *applicationId, request, application.DefaultSetVerifiedPublisherOperationOptions()); err != nil {
+				return fmt.Errorf("setting %s: %+v", id, err)
+			}
+
+			if err = waitForVerifiedPublisher(ctx, client, *applicationId, model.VerifiedPublisherId); err != nil {
+				return fmt.Errorf("waiting for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationVerifiedPublisherResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationClient
+
+			id, err := parse.ParseVerifiedPublisherID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			applicationId := stable.NewApplicationID(id.ApplicationId)
+
+			options := application.GetApplicationOperationOptions{
+				Select: pointer.To([]string{"verifiedPublisher"}),
+			}
+
+			resp, err := client.GetApplication(ctx, applicationId, options)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: result was nil", id)
+			}
+
+			vp := resp.Model.VerifiedPublisher
+			if vp == nil || vp.VerifiedPublisherId.GetOrZero() == "" {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ApplicationVerifiedPublisherModel{
+				ApplicationId:       applicationId.ID(),
+				VerifiedPublisherId: vp.VerifiedPublisherId.GetOrZero(),
+				DisplayName:         vp.DisplayName.GetOrZero(),
+				AddedDateTime:       vp.AddedDateTime.GetOrZero(),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationVerifiedPublisherResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationClient
+
+			id, err := parse.ParseVerifiedPublisherID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			applicationId := stable.NewApplicationID(id.ApplicationId)
+
+			var model ApplicationVerifiedPublisherModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			tf.LockByName(applicationResourceName, id.ApplicationId)
+			defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+			if metadata.ResourceData.HasChange("verified_publisher_id") {
+				request := application.SetVerifiedPublisherRequest{
+					VerifiedPublisherId: pointer.To(model.VerifiedPublisherId),
+				}
+
+				if _, err = client.SetVerifiedPublisher(ctx, applicationId, request, application.DefaultSetVerifiedPublisherOperationOptions()); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+
+				if err = waitForVerifiedPublisher(ctx, client, applicationId, model.VerifiedPublisherId); err != nil {
+					return fmt.Errorf("waiting for update of %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationVerifiedPublisherResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationClient
+
+			id, err := parse.ParseVerifiedPublisherID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			applicationId := stable.NewApplicationID(id.ApplicationId)
+
+			tf.LockByName(applicationResourceName, id.ApplicationId)
+			defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+			if resp, err := client.UnsetVerifiedPublisher(ctx, applicationId, application.DefaultUnsetVerifiedPublisherOperationOptions()); err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("unsetting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// waitForVerifiedPublisher waits for the verified publisher of an application to replicate, since reading it too soon
+// would otherwise remove the resource from state
+func waitForVerifiedPublisher(ctx context.Context, client *application.ApplicationClient, applicationId stable.ApplicationId, verifiedPublisherId string) error {
+	options := application.GetApplicationOperationOptions{
+		Select: pointer.To([]string{"verifiedPublisher"}),
+	}
+
+	return consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetApplication(ctx, applicationId, options)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		if resp.Model == nil || resp.Model.VerifiedPublisher == nil {
+			return pointer.To(false), nil
+		}
+
+		return pointer.To(resp.Model.VerifiedPublisher.VerifiedPublisherId.GetOrZero() == verifiedPublisherId), nil
+	})
+}
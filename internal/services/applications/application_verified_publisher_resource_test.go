@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/applications/parse"
+)
+
+type ApplicationVerifiedPublisherResource struct{}
+
+func TestAccApplicationVerifiedPublisher_basic(t *testing.T) {
+	// Setting a verified publisher requires an MPN ID associated with the tenant's verified domain
+	mpnId := os.Getenv("ARM_TEST_VERIFIED_PUBLISHER_ID")
+	if mpnId == "" {
+		t.Skip("ARM_TEST_VERIFIED_PUBLISHER_ID must be set to run this test")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_application_verified_publisher", "test")
+	r := ApplicationVerifiedPublisherResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, mpnId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("verified_publisher_id").HasValue(mpnId),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("added_date_time").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationVerifiedPublisherResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Applications.ApplicationClient
+
+	id, err := parse.ParseVerifiedPublisherID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationId := stable.NewApplicationID(id.ApplicationId)
+
+	resp, err := client.GetApplication(ctx, applicationId, application.DefaultGetApplicationOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", applicationId, err)
+	}
+
+	app := resp.Model
+	if app == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", applicationId)
+	}
+
+	if app.VerifiedPublisher == nil || app.VerifiedPublisher.VerifiedPublisherId.GetOrZero() == "" {
+		return pointer.To(false), nil
+	}
+
+	return pointer.To(true), nil
+}
+
+func (ApplicationVerifiedPublisherResource) basic(data acceptance.TestData, mpnId string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application_registration" "test" {
+  display_name = "acctest-VerifiedPublisher-%[1]d"
+}
+
+resource "azuread_application_verified_publisher" "test" {
+  application_id        = azuread_application_registration.test.id
+  verified_publisher_id = "%[2]s"
+}
+`, data.RandomInteger, mpnId)
+}
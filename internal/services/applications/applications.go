@@ -629,6 +629,30 @@ func expandApplicationResourceAccess(in []interface{}) *[]stable.ResourceAccess
 	return &result
 }
 
+// expandApplicationServicePrincipalLockConfiguration returns an explicitly disabled configuration when the block is
+// removed, since omitting the property from a PATCH leaves any existing lock in place
+func expandApplicationServicePrincipalLockConfiguration(input []interface{}) *stable.ServicePrincipalLockConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return &stable.ServicePrincipalLockConfiguration{
+			IsEnabled:                  pointer.To(false),
+			AllProperties:              nullable.Value(false),
+			CredentialsWithUsageSign:   nullable.Value(false),
+			CredentialsWithUsageVerify: nullable.Value(false),
+			TokenEncryptionKeyId:       nullable.Value(false),
+		}
+	}
+
+	in := input[0].(map[string]interface{})
+
+	return &stable.ServicePrincipalLockConfiguration{
+		IsEnabled:                  pointer.To(in["enabled"].(bool)),
+		AllProperties:              nullable.Value(in["all_properties"].(bool)),
+		CredentialsWithUsageSign:   nullable.Value(in["credentials_with_usage_sign"].(bool)),
+		CredentialsWithUsageVerify: nullable.Value(in["credentials_with_usage_verify"].(bool)),
+		TokenEncryptionKeyId:       nullable.Value(in["token_encryption_key_id"].(bool)),
+	}
+}
+
 func expandApplicationSpa(input []interface{}) (result *stable.SpaApplication) {
 	result = &stable.SpaApplication{
 		RedirectUris: &[]string{},
@@ -803,6 +827,39 @@ func flattenApplicationResourceAccess(in *[]stable.ResourceAccess) []interface{}
 	return accesses
 }
 
+// importApplicationServicePrincipalLock populates the `service_principal_lock` block when importing an application
+// with an enabled lock. The block is otherwise only tracked once configured, so that a lock applied by default to new
+// applications is not reported as drift, and a disabled lock is equivalent to omitting the block.
+func importApplicationServicePrincipalLock(ctx context.Context, client *application.ApplicationClient, id stable.ApplicationId, d *pluginsdk.ResourceData) error {
+	resp, err := client.GetApplication(ctx, id, application.DefaultGetApplicationOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil {
+		return fmt.Errorf("retrieving %s: model was nil", id)
+	}
+
+	if lock := resp.Model.ServicePrincipalLockConfiguration; lock != nil && pointer.From(lock.IsEnabled) {
+		return d.Set("service_principal_lock", flattenApplicationServicePrincipalLockConfiguration(lock))
+	}
+
+	return nil
+}
+
+func flattenApplicationServicePrincipalLockConfiguration(in *stable.ServicePrincipalLockConfiguration) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"enabled":                       pointer.From(in.IsEnabled),
+		"all_properties":                in.AllProperties.GetOrZero(),
+		"credentials_with_usage_sign":   in.CredentialsWithUsageSign.GetOrZero(),
+		"credentials_with_usage_verify": in.CredentialsWithUsageVerify.GetOrZero(),
+		"token_encryption_key_id":       in.TokenEncryptionKeyId.GetOrZero(),
+	}}
+}
+
 func flattenApplicationSpa(in *stable.SpaApplication) []map[string]interface{} {
 	if in == nil {
 		return []map[string]interface{}{}
@@ -845,3 +902,15 @@ func flattenApplicationWeb(in *stable.WebApplication) []map[string]interface{} {
 		"implicit_grant": flattenApplicationImplicitGrant(in.ImplicitGrantSettings),
 	}}
 }
+
+func flattenApplicationVerifiedPublisher(in *stable.VerifiedPublisher) []map[string]interface{} {
+	if in == nil || in.VerifiedPublisherId.GetOrZero() == "" {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"verified_publisher_id": in.VerifiedPublisherId.GetOrZero(),
+		"display_name":          in.DisplayName.GetOrZero(),
+		"added_date_time":       in.AddedDateTime.GetOrZero(),
+	}}
+}
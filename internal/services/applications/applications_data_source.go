@@ -0,0 +1,532 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	serviceprincipalsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/serviceprincipals/client"
+)
+
+var (
+	applicationsDataSourceLookupKeys = []string{"client_ids", "display_names", "identifier_uris", "object_ids"}
+	applicationsDataSourceSearchKeys = []string{"display_name_prefix", "filter", "owners", "tags"}
+	applicationsDataSourceAllKeys    = append(append(append([]string{}, applicationsDataSourceLookupKeys...), applicationsDataSourceSearchKeys...), "return_all")
+)
+
+// applicationsDataSourceConflicts returns all lookup, search and return_all keys, excluding the key itself and any
+// keys in the same group that may be combined with it
+func applicationsDataSourceConflicts(key string, combinable ...string) []string {
+	result := make([]string, 0)
+	for _, k := range applicationsDataSourceAllKeys {
+		if k == key {
+			continue
+		}
+		skip := false
+		for _, c := range combinable {
+			if k == c {
+				skip = true
+				break
+			}
+		}
+		if !skip {
+			result = append(result, k)
+		}
+	}
+	return result
+}
+
+func applicationsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: applicationsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"client_ids": {
+				Description:   "The client IDs of the applications",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  applicationsDataSourceAllKeys,
+				ConflictsWith: applicationsDataSourceConflicts("client_ids"),
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"display_names": {
+				Description:   "The display names of the applications",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  applicationsDataSourceAllKeys,
+				ConflictsWith: applicationsDataSourceConflicts("display_names"),
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"identifier_uris": {
+				Description:   "The identifier URIs of the applications",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				AtLeastOneOf:  applicationsDataSourceAllKeys,
+				ConflictsWith: applicationsDataSourceConflicts("identifier_uris"),
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"object_ids": {
+				Description:   "The object IDs of the applications",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  applicationsDataSourceAllKeys,
+				ConflictsWith: applicationsDataSourceConflicts("object_ids"),
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"display_name_prefix": {
+				Description:   "Common display name prefix of the applications",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  applicationsDataSourceAllKeys,
+				ConflictsWith: applicationsDataSourceConflicts("display_name_prefix", applicationsDataSourceSearchKeys...),
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"filter": {
+				Description:   "An OData filter expression used to search for applications",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  applicationsDataSourceAllKeys,
+				ConflictsWith: applicationsDataSourceConflicts("filter", applicationsDataSourceSearchKeys...),
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"owners": {
+				Description:   "A set of object IDs of principals, all of which must be owners of the applications",
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				AtLeastOneOf:  applicationsDataSourceAllKeys,
+				ConflictsWith: applicationsDataSourceConflicts("owners", applicationsDataSourceSearchKeys...),
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"tags": {
+				Description:   "A set of tags, all of which must be applied to the applications",
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				AtLeastOneOf:  applicationsDataSourceAllKeys,
+				ConflictsWith: applicationsDataSourceConflicts("tags", applicationsDataSourceSearchKeys...),
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"ignore_missing": {
+				Description:   "Ignore missing applications and return the applications that were found. The data source will still fail if no applications are found",
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: append([]string{"return_all"}, applicationsDataSourceSearchKeys...),
+			},
+
+			"return_all": {
+				Description:   "Fetch all applications with no filter and return all that were found. The data source will still fail if no applications are found.",
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				AtLeastOneOf:  applicationsDataSourceAllKeys,
+				ConflictsWith: append([]string{"ignore_missing"}, applicationsDataSourceConflicts("return_all")...),
+			},
+
+			"applications": {
+				Description: "A list of applications",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"client_id": {
+							Description: "The Client ID for the application",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"created_date_time": {
+							Description: "The date and time the application was registered",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"disabled_by_microsoft": {
+							Description: "Whether Microsoft has disabled the registered application",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The display name for the application",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"identifier_uris": {
+							Description: "A list of user-defined URI(s) that uniquely identify a Web application within its Azure AD tenant, or within a verified custom domain if the application is multi-tenant",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"notes": {
+							Description: "User-specified notes relevant for the management of the application",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"object_id": {
+							Description: "The application's object ID",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"publisher_domain": {
+							Description: "The verified publisher domain for the application",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"service_management_reference": {
+							Description: "References application or service contact information from a Service or Asset Management database",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"sign_in_audience": {
+							Description: "The Microsoft account types that are supported for the current application",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"tags": {
+							Description: "A set of tags applied to the application",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func applicationsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationClient
+
+	var applications []stable.Application
+	var expectedCount int
+	var lookup bool
+	ignoreMissing := d.Get("ignore_missing").(bool)
+	returnAll := d.Get("return_all").(bool)
+
+	fieldsToSelect := []string{
+		"appId",
+		"createdDateTime",
+		"disabledByMicrosoftStatus",
+		"displayName",
+		"id",
+		"identifierUris",
+		"notes",
+		"publisherDomain",
+		"serviceManagementReference",
+		"signInAudience",
+		"tags",
+	}
+
+	// findOne looks up a single application using the provided filter, for each value in a lookup list
+	findOne := func(attr, description, value, filter string) pluginsdk.Diagnostics {
+		options := application.ListApplicationsOperationOptions{
+			Filter: pointer.To(filter),
+			Select: &fieldsToSelect,
+		}
+		resp, err := client.ListApplications(ctx, options)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Finding applications with %s: %q", description, value)
+		}
+		if resp.Model == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+		}
+
+		if count := len(*resp.Model); count > 1 {
+			return tf.ErrorDiagPathF(nil, attr, "More than one application found with %s: %q", description, value)
+		} else if count == 0 {
+			if ignoreMissing {
+				return nil
+			}
+			return tf.ErrorDiagPathF(nil, attr, "Application not found with %s: %q", description, value)
+		}
+
+		applications = append(applications, (*resp.Model)[0])
+		return nil
+	}
+
+	if returnAll {
+		resp, err := client.ListApplications(ctx, application.ListApplicationsOperationOptions{Select: &fieldsToSelect})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve applications")
+		}
+		if resp.Model == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+		}
+		if len(*resp.Model) == 0 {
+			return tf.ErrorDiagPathF(err, "return_all", "No applications found")
+		}
+
+		applications = append(applications, *resp.Model...)
+
+	} else if clientIds := tf.ExpandStringSlice(d.Get("client_ids").([]interface{})); len(clientIds) > 0 {
+		lookup = true
+		expectedCount = len(clientIds)
+		for _, v := range clientIds {
+			if diags := findOne("client_ids", "client ID", v, fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(v))); diags != nil {
+				return diags
+			}
+		}
+
+	} else if displayNames := tf.ExpandStringSlice(d.Get("display_names").([]interface{})); len(displayNames) > 0 {
+		lookup = true
+		expectedCount = len(displayNames)
+		for _, v := range displayNames {
+			if diags := findOne("display_names", "display name", v, fmt.Sprintf("displayName eq '%s'", odata.EscapeSingleQuote(v))); diags != nil {
+				return diags
+			}
+		}
+
+	} else if identifierUris := tf.ExpandStringSlice(d.Get("identifier_uris").([]interface{})); len(identifierUris) > 0 {
+		lookup = true
+		expectedCount = len(identifierUris)
+		for _, v := range identifierUris {
+			if diags := findOne("identifier_uris", "identifier URI", v, fmt.Sprintf("identifierUris/any(uri:uri eq '%s')", odata.EscapeSingleQuote(v))); diags != nil {
+				return diags
+			}
+		}
+
+	} else if objectIds := tf.ExpandStringSlice(d.Get("object_ids").([]interface{})); len(objectIds) > 0 {
+		lookup = true
+		expectedCount = len(objectIds)
+		for _, v := range objectIds {
+			resp, err := client.GetApplication(ctx, stable.NewApplicationID(v), application.GetApplicationOperationOptions{Select: &fieldsToSelect})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					if ignoreMissing {
+						continue
+					}
+					return tf.ErrorDiagPathF(nil, "object_ids", "Application not found with object ID: %q", v)
+				}
+				return tf.ErrorDiagF(err, "Retrieving application with object ID: %q", v)
+			}
+			if resp.Model == nil {
+				return tf.ErrorDiagPathF(nil, "object_ids", "Application not found with object ID: %q", v)
+			}
+
+			applications = append(applications, *resp.Model)
+		}
+
+	} else {
+		filters := make([]string, 0)
+
+		if v := d.Get("display_name_prefix").(string); v != "" {
+			filters = append(filters, fmt.Sprintf("startswith(displayName, '%s')", odata.EscapeSingleQuote(v)))
+		}
+		for _, v := range tf.ExpandStringSlice(d.Get("tags").(*pluginsdk.Set).List()) {
+			filters = append(filters, fmt.Sprintf("tags/any(t:t eq '%s')", odata.EscapeSingleQuote(v)))
+		}
+		if v := d.Get("filter").(string); v != "" {
+			filters = append(filters, fmt.Sprintf("(%s)", v))
+		}
+
+		// Applications cannot be filtered by owner, so the applications owned by each owner are intersected instead
+		var owned []stable.Application
+		ownerIds := tf.ExpandStringSlice(d.Get("owners").(*pluginsdk.Set).List())
+		if len(ownerIds) > 0 {
+			var err error
+			if owned, err = applicationsOwnedByAll(ctx, meta.(*clients.Client).ServicePrincipals.DirectoryObjectClient, meta.(*clients.Client).ServicePrincipals.OwnedObjectClient, ownerIds); err != nil {
+				return tf.ErrorDiagPathF(err, "owners", "Listing applications for owners")
+			}
+		}
+
+		// An empty lookup list results in no filters, in which case no applications are returned
+		if len(filters) > 0 {
+			options := application.ListApplicationsOperationOptions{
+				Filter: pointer.To(strings.Join(filters, " and ")),
+				Select: &fieldsToSelect,
+			}
+
+			resp, err := client.ListApplications(ctx, options)
+			if err != nil {
+				return tf.ErrorDiagF(err, "Listing applications for filter %q", *options.Filter)
+			}
+			if resp.Model == nil {
+				return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+			}
+
+			if len(ownerIds) == 0 {
+				applications = append(applications, *resp.Model...)
+			} else {
+				ownedIds := make(map[string]bool)
+				for _, app := range owned {
+					ownedIds[strings.ToLower(pointer.From(app.Id))] = true
+				}
+				for _, app := range *resp.Model {
+					if ownedIds[strings.ToLower(pointer.From(app.Id))] {
+						applications = append(applications, app)
+					}
+				}
+			}
+		} else {
+			applications = append(applications, owned...)
+		}
+	}
+
+	// Check that the right number of applications were returned
+	if lookup && !ignoreMissing && len(applications) != expectedCount {
+		return tf.ErrorDiagF(fmt.Errorf("expected: %d, actual: %d", expectedCount, len(applications)), "Unexpected number of applications returned")
+	}
+
+	clientIds := make([]string, 0)
+	displayNames := make([]string, 0)
+	objectIds := make([]string, 0)
+	appList := make([]map[string]interface{}, 0)
+	for _, app := range applications {
+		if app.Id == nil {
+			return tf.ErrorDiagF(errors.New("API returned application with nil object ID"), "Bad API Response")
+		}
+
+		objectIds = append(objectIds, *app.Id)
+		displayNames = append(displayNames, app.DisplayName.GetOrZero())
+		clientIds = append(clientIds, app.AppId.GetOrZero())
+
+		a := make(map[string]interface{})
+		a["client_id"] = app.AppId.GetOrZero()
+		a["created_date_time"] = app.CreatedDateTime.GetOrZero()
+		a["disabled_by_microsoft"] = app.DisabledByMicrosoftStatus.GetOrZero()
+		a["display_name"] = app.DisplayName.GetOrZero()
+		a["identifier_uris"] = pointer.From(app.IdentifierUris)
+		a["notes"] = app.Notes.GetOrZero()
+		a["object_id"] = pointer.From(app.Id)
+		a["publisher_domain"] = app.PublisherDomain.GetOrZero()
+		a["service_management_reference"] = app.ServiceManagementReference.GetOrZero()
+		a["sign_in_audience"] = app.SignInAudience.GetOrZero()
+		a["tags"] = pointer.From(app.Tags)
+		appList = append(appList, a)
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(objectIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for object IDs")
+	}
+
+	d.SetId("applications#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "applications", appList)
+	tf.Set(d, "client_ids", clientIds)
+	tf.Set(d, "display_names", displayNames)
+	tf.Set(d, "object_ids", objectIds)
+
+	return nil
+}
+
+// applicationsOwnedByAll returns the applications which are owned by all the specified principals
+func applicationsOwnedByAll(ctx context.Context, directoryObjectClient *directoryobject.DirectoryObjectClient, c *serviceprincipalsClient.OwnedObjectClient, ownerIds []string) ([]stable.Application, error) {
+	result := make([]stable.Application, 0)
+
+	for i, ownerId := range ownerIds {
+		// Owners may be either users or service principals, so determine which one
+		objectResp, err := directoryObjectClient.GetDirectoryObject(ctx, stable.NewDirectoryObjectID(ownerId), directoryobject.DefaultGetDirectoryObjectOperationOptions())
+		if err != nil {
+			if response.WasNotFound(objectResp.HttpResponse) {
+				return nil, fmt.Errorf("owner not found with object ID: %q", ownerId)
+			}
+			return nil, fmt.Errorf("retrieving owner with object ID %q: %+v", ownerId, err)
+		}
+		if objectResp.Model == nil {
+			return nil, fmt.Errorf("retrieving owner with object ID %q: model was nil", ownerId)
+		}
+
+		var principalId resourceids.ResourceId
+		switch odataType := strings.TrimPrefix(pointer.From(objectResp.Model.DirectoryObject().ODataType), "#microsoft.graph."); odataType {
+		case "servicePrincipal":
+			principalId = pointer.To(stable.NewServicePrincipalID(ownerId))
+		case "user":
+			principalId = pointer.To(stable.NewUserID(ownerId))
+		default:
+			return nil, fmt.Errorf("owner with object ID %q has unsupported object type %q, expected a service principal or user", ownerId, odataType)
+		}
+
+		resp, err := c.ListOwnedObjects(ctx, principalId, serviceprincipalsClient.ListOwnedObjectsOperationOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("listing owned objects for owner with object ID %q: %+v", ownerId, err)
+		}
+
+		ownedIds := make(map[string]bool)
+		applications := make([]stable.Application, 0)
+		for _, object := range pointer.From(resp.Model) {
+			if app, ok := object.(stable.Application); ok && app.Id != nil {
+				ownedIds[strings.ToLower(*app.Id)] = true
+				applications = append(applications, app)
+			}
+		}
+
+		if i == 0 {
+			result = applications
+			continue
+		}
+
+		intersection := make([]stable.Application, 0)
+		for _, app := range result {
+			if ownedIds[strings.ToLower(*app.Id)] {
+				intersection = append(intersection, app)
+			}
+		}
+		result = intersection
+	}
+
+	return result, nil
+}
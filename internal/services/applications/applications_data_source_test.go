@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ApplicationsDataSource struct{}
+
+func TestAccApplicationsDataSource_byClientIds(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.byClientIds(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("client_ids.#").HasValue("2"),
+			check.That(data.ResourceName).Key("display_names.#").HasValue("2"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("2"),
+			check.That(data.ResourceName).Key("applications.#").HasValue("2"),
+		),
+	}})
+}
+
+func TestAccApplicationsDataSource_byDisplayNamesWithIgnoreMissing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.byDisplayNamesWithIgnoreMissing(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("display_names.#").HasValue("3"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("3"),
+			check.That(data.ResourceName).Key("applications.#").HasValue("3"),
+		),
+	}})
+}
+
+func TestAccApplicationsDataSource_byIdentifierUris(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.byIdentifierUris(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("applications.#").HasValue("1"),
+			check.That(data.ResourceName).Key("applications.0.identifier_uris.#").HasValue("1"),
+		),
+	}})
+}
+
+func TestAccApplicationsDataSource_byDisplayNamePrefixAndTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.byDisplayNamePrefixAndTags(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("applications.#").HasValue("2"),
+			check.That(data.ResourceName).Key("applications.0.tags.#").HasValue("2"),
+		),
+	}})
+}
+
+func TestAccApplicationsDataSource_byOwners(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.byOwners(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("applications.#").HasValue("1"),
+			check.That(data.ResourceName).Key("object_ids.0").MatchesOtherKey(check.That("azuread_application.testC").Key("object_id")),
+		),
+	}})
+}
+
+func TestAccApplicationsDataSource_byOwnersOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.byOwnersOnly(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("applications.#").HasValue("1"),
+			check.That(data.ResourceName).Key("object_ids.0").MatchesOtherKey(check.That("azuread_application.testC").Key("object_id")),
+			check.That(data.ResourceName).Key("applications.0.display_name").MatchesOtherKey(check.That("azuread_application.testC").Key("display_name")),
+		),
+	}})
+}
+
+func TestAccApplicationsDataSource_noNames(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.noNames(),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("display_names.#").HasValue("0"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("0"),
+			check.That(data.ResourceName).Key("applications.#").HasValue("0"),
+		),
+	}})
+}
+
+func TestAccApplicationsDataSource_returnAll(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.returnAll(),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("display_names.#").Exists(),
+			check.That(data.ResourceName).Key("object_ids.#").Exists(),
+			check.That(data.ResourceName).Key("applications.#").Exists(),
+		),
+	}})
+}
+
+func (ApplicationsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_client_config" "test" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestApplications.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestApplications-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_application" "testA" {
+  display_name = "acctestApplications-%[1]d-A"
+  tags         = ["acctest-%[1]d", "team-a"]
+}
+
+resource "azuread_application" "testB" {
+  display_name = "acctestApplications-%[1]d-B"
+  tags         = ["acctest-%[1]d", "team-a"]
+}
+
+resource "azuread_application" "testC" {
+  display_name    = "acctestApplications-%[1]d-C"
+  identifier_uris = ["api://acctestApplications-%[1]d-C"]
+  owners          = [data.azuread_client_config.test.object_id, azuread_user.test.object_id]
+  tags            = ["acctest-%[1]d", "team-c"]
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r ApplicationsDataSource) byClientIds(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_applications" "test" {
+  client_ids = [
+    azuread_application.testA.client_id,
+    azuread_application.testB.client_id,
+  ]
+}
+`, r.template(data))
+}
+
+func (r ApplicationsDataSource) byDisplayNamesWithIgnoreMissing(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_applications" "test" {
+  ignore_missing = true
+
+  display_names = [
+    azuread_application.testA.display_name,
+    "not-a-real-application-%[2]d-g1bb3r1sh",
+    azuread_application.testB.display_name,
+    azuread_application.testC.display_name,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationsDataSource) byIdentifierUris(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_applications" "test" {
+  identifier_uris = azuread_application.testC.identifier_uris
+}
+`, r.template(data))
+}
+
+func (r ApplicationsDataSource) byDisplayNamePrefixAndTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_applications" "test" {
+  display_name_prefix = "acctestApplications-%[2]d"
+  tags                = ["acctest-%[2]d", "team-a"]
+
+  depends_on = [
+    azuread_application.testA,
+    azuread_application.testB,
+    azuread_application.testC,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationsDataSource) byOwners(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_applications" "test" {
+  owners = [azuread_user.test.object_id]
+  filter = "startswith(displayName, 'acctestApplications-%[2]d')"
+
+  depends_on = [
+    azuread_application.testA,
+    azuread_application.testB,
+    azuread_application.testC,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationsDataSource) byOwnersOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_applications" "test" {
+  owners = [data.azuread_client_config.test.object_id, azuread_user.test.object_id]
+
+  depends_on = [
+    azuread_application.testA,
+    azuread_application.testB,
+    azuread_application.testC,
+  ]
+}
+`, r.template(data))
+}
+
+func (ApplicationsDataSource) noNames() string {
+	return `
+data "azuread_applications" "test" {
+  display_names = []
+}
+`
+}
+
+func (ApplicationsDataSource) returnAll() string {
+	return `
+data "azuread_applications" "test" {
+  return_all = true
+}
+`
+}
@@ -12,18 +12,25 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/owner"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applicationtemplates/stable/applicationtemplate"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/oauth2permissiongrants/stable/oauth2permissiongrant"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignedto"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
 	"github.com/valiparsa/terraform-provider-azuread/internal/common"
+	appRoleAssignmentsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/approleassignments/client"
 )
 
 type Client struct {
-	ApplicationClient                      *application.ApplicationClient
-	ApplicationClientBeta                  *applicationBeta.ApplicationClient
-	ApplicationLogoClient                  *logo.LogoClient
-	ApplicationOwnerClient                 *owner.OwnerClient
-	ApplicationFederatedIdentityCredential *federatedidentitycredential.FederatedIdentityCredentialClient
-	ApplicationTemplateClient              *applicationtemplate.ApplicationTemplateClient
-	ServicePrincipalClient                 *serviceprincipal.ServicePrincipalClient
+	AppRoleAssignedToClient                    *approleassignedto.AppRoleAssignedToClient
+	AppRoleAssignmentClient                    *appRoleAssignmentsClient.AppRoleAssignmentClient
+	ApplicationClient                          *application.ApplicationClient
+	ApplicationClientBeta                      *applicationBeta.ApplicationClient
+	ApplicationLogoClient                      *logo.LogoClient
+	ApplicationOwnerClient                     *owner.OwnerClient
+	ApplicationFederatedIdentityCredential     *federatedidentitycredential.FederatedIdentityCredentialClient
+	ApplicationFederatedIdentityCredentialBeta *FederatedIdentityCredentialClientBeta
+	ApplicationTemplateClient                  *applicationtemplate.ApplicationTemplateClient
+	OAuth2PermissionGrantClient                *oauth2permissiongrant.OAuth2PermissionGrantClient
+	ServicePrincipalClient                     *serviceprincipal.ServicePrincipalClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -58,6 +65,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(applicationFederatedIdentityCredentialClient.Client)
 
+	// Flexible federated identity credentials (claimsMatchingExpression) are only supported in the beta API
+	applicationFederatedIdentityCredentialClientBeta, err := NewFederatedIdentityCredentialClientBetaWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(applicationFederatedIdentityCredentialClientBeta.Client)
+
 	applicationTemplateClient, err := applicationtemplate.NewApplicationTemplateClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -70,6 +84,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(directoryObjectClient.Client)
 
+	appRoleAssignedToClient, err := approleassignedto.NewAppRoleAssignedToClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(appRoleAssignedToClient.Client)
+
+	appRoleAssignmentClient, err := appRoleAssignmentsClient.NewAppRoleAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(appRoleAssignmentClient.Client)
+
+	oAuth2PermissionGrantClient, err := oauth2permissiongrant.NewOAuth2PermissionGrantClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(oAuth2PermissionGrantClient.Client)
+
 	servicePrincipalClient, err := serviceprincipal.NewServicePrincipalClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -77,12 +109,16 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(servicePrincipalClient.Client)
 
 	return &Client{
-		ApplicationClient:                      applicationClient,
-		ApplicationClientBeta:                  applicationClientBeta,
-		ApplicationLogoClient:                  applicationLogoClient,
-		ApplicationOwnerClient:                 applicationOwnerClient,
-		ApplicationFederatedIdentityCredential: applicationFederatedIdentityCredentialClient,
-		ApplicationTemplateClient:              applicationTemplateClient,
-		ServicePrincipalClient:                 servicePrincipalClient,
+		AppRoleAssignedToClient:                    appRoleAssignedToClient,
+		AppRoleAssignmentClient:                    appRoleAssignmentClient,
+		ApplicationClient:                          applicationClient,
+		ApplicationClientBeta:                      applicationClientBeta,
+		ApplicationLogoClient:                      applicationLogoClient,
+		ApplicationOwnerClient:                     applicationOwnerClient,
+		ApplicationFederatedIdentityCredential:     applicationFederatedIdentityCredentialClient,
+		ApplicationFederatedIdentityCredentialBeta: applicationFederatedIdentityCredentialClientBeta,
+		ApplicationTemplateClient:                  applicationTemplateClient,
+		OAuth2PermissionGrantClient:                oAuth2PermissionGrantClient,
+		ServicePrincipalClient:                     servicePrincipalClient,
 	}, nil
 }
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/graphrequest"
+)
+
+// FederatedIdentityCredentialClientBeta manages federated identity credentials using the beta API, which is currently
+// the only API version that supports flexible credentials with a `claimsMatchingExpression`.
+type FederatedIdentityCredentialClientBeta struct {
+	Client *msgraph.Client
+}
+
+func NewFederatedIdentityCredentialClientBetaWithBaseURI(api environments.Api) (*FederatedIdentityCredentialClientBeta, error) {
+	c, err := msgraph.NewClient(api, "federatedidentitycredential", msgraph.VersionBeta)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating FederatedIdentityCredentialClientBeta: %+v", err)
+	}
+
+	return &FederatedIdentityCredentialClientBeta{
+		Client: c,
+	}, nil
+}
+
+type FederatedIdentityCredentialBetaOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *beta.FederatedIdentityCredential
+}
+
+// CreateFederatedIdentityCredential - Create a new federated identity credential for an application
+func (c FederatedIdentityCredentialClientBeta) CreateFederatedIdentityCredential(ctx context.Context, id beta.ApplicationId, input beta.FederatedIdentityCredential) (FederatedIdentityCredentialBetaOperationResponse, error) {
+	return c.execute(ctx, http.MethodPost, fmt.Sprintf("%s/federatedIdentityCredentials", id.ID()), input, true)
+}
+
+// GetFederatedIdentityCredential - Retrieve the properties of a federated identity credential for an application
+func (c FederatedIdentityCredentialClientBeta) GetFederatedIdentityCredential(ctx context.Context, id beta.ApplicationIdFederatedIdentityCredentialId) (FederatedIdentityCredentialBetaOperationResponse, error) {
+	return c.execute(ctx, http.MethodGet, id.ID(), nil, true)
+}
+
+// UpdateFederatedIdentityCredential - Update the properties of a federated identity credential for an application
+func (c FederatedIdentityCredentialClientBeta) UpdateFederatedIdentityCredential(ctx context.Context, id beta.ApplicationIdFederatedIdentityCredentialId, input beta.FederatedIdentityCredential) (FederatedIdentityCredentialBetaOperationResponse, error) {
+	return c.execute(ctx, http.MethodPatch, id.ID(), input, false)
+}
+
+func (c FederatedIdentityCredentialClientBeta) execute(ctx context.Context, method, path string, input interface{}, unmarshal bool) (result FederatedIdentityCredentialBetaOperationResponse, err error) {
+	var resp *client.Response
+	resp, err = graphrequest.Execute(ctx, c.Client, method, path, input, false, graphrequest.Options{})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil || !unmarshal {
+		return
+	}
+
+	var model beta.FederatedIdentityCredential
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
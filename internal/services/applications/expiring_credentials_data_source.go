@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/credentials"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+const (
+	expiringCredentialOwnerTypeApplication      = "Application"
+	expiringCredentialOwnerTypeServicePrincipal = "ServicePrincipal"
+)
+
+func expiringCredentialsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: expiringCredentialsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"expires_within": {
+				Description:  "A duration, for example `720h` (30 days), within which credentials must expire in order to be returned",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"include_expired": {
+				Description: "Whether to also return credentials that have already expired",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"object_types": {
+				Description: "The types of objects for which to return credentials. Possible values are `Application` and `ServicePrincipal`. Defaults to both",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{expiringCredentialOwnerTypeApplication, expiringCredentialOwnerTypeServicePrincipal}, false),
+				},
+			},
+
+			"credentials": {
+				Description: "A list of expiring credentials, ordered by end date",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"client_id": {
+							Description: "The client ID of the application associated with the owner of the credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The display name of the credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"end_date": {
+							Description: "The end date until which the credential is valid, formatted as an RFC3339 date string",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"expired": {
+							Description: "Whether the credential has already expired",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+
+						"key_id": {
+							Description: "The unique key ID of the credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"owner_display_name": {
+							Description: "The display name of the application or service principal owning the credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"owner_object_id": {
+							Description: "The object ID of the application or service principal owning the credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"owner_type": {
+							Description: "The type of object owning the credential, either `Application` or `ServicePrincipal`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"start_date": {
+							Description: "The start date from which the credential is valid, formatted as an RFC3339 date string",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"type": {
+							Description: "The type of credential, either `Password`, `AsymmetricX509Cert` or `Symmetric`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"usage": {
+							Description: "The usage of a key credential, either `Sign` (e.g. for token signing certificates) or `Verify`. Empty for password credentials",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type expiringCredentialResult struct {
+	credentials.ExpiringCredential
+	ClientId         string
+	OwnerDisplayName string
+	OwnerObjectId    string
+	OwnerType        string
+}
+
+func expiringCredentialsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	applicationClient := meta.(*clients.Client).Applications.ApplicationClient
+	servicePrincipalClient := meta.(*clients.Client).Applications.ServicePrincipalClient
+
+	window, err := time.ParseDuration(d.Get("expires_within").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "expires_within", "Unable to parse `expires_within` (%q) as a duration", d.Get("expires_within").(string))
+	}
+
+	now := time.Now()
+	cutoff := now.Add(window)
+	includeExpired := d.Get("include_expired").(bool)
+
+	objectTypes := tf.ExpandStringSlice(d.Get("object_types").(*pluginsdk.Set).List())
+	if len(objectTypes) == 0 {
+		objectTypes = []string{expiringCredentialOwnerTypeApplication, expiringCredentialOwnerTypeServicePrincipal}
+	}
+
+	fieldsToSelect := []string{"appId", "displayName", "id", "keyCredentials", "passwordCredentials"}
+
+	results := make([]expiringCredentialResult, 0)
+
+	appendResults := func(ownerType, ownerObjectId, ownerDisplayName, clientId string, passwordCredentials *[]stable.PasswordCredential, keyCredentials *[]stable.KeyCredential) error {
+		expiringPasswords, err := credentials.ExpiringPasswordCredentials(passwordCredentials, cutoff)
+		if err != nil {
+			return err
+		}
+		expiringKeys, err := credentials.ExpiringKeyCredentials(keyCredentials, cutoff)
+		if err != nil {
+			return err
+		}
+
+		for _, cred := range append(expiringPasswords, expiringKeys...) {
+			if !includeExpired && cred.Expired(now) {
+				continue
+			}
+			results = append(results, expiringCredentialResult{
+				ExpiringCredential: cred,
+				ClientId:           clientId,
+				OwnerDisplayName:   ownerDisplayName,
+				OwnerObjectId:      ownerObjectId,
+				OwnerType:          ownerType,
+			})
+		}
+
+		return nil
+	}
+
+	for _, objectType := range objectTypes {
+		switch objectType {
+		case expiringCredentialOwnerTypeApplication:
+			resp, err := applicationClient.ListApplications(ctx, application.ListApplicationsOperationOptions{Select: &fieldsToSelect})
+			if err != nil {
+				return tf.ErrorDiagF(err, "Could not retrieve applications")
+			}
+			if resp.Model == nil {
+				return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+			}
+
+			for _, app := range *resp.Model {
+				if app.Id == nil {
+					return tf.ErrorDiagF(errors.New("API returned application with nil object ID"), "Bad API Response")
+				}
+				if err = appendResults(objectType, *app.Id, app.DisplayName.GetOrZero(), app.AppId.GetOrZero(), app.PasswordCredentials, app.KeyCredentials); err != nil {
+					return tf.ErrorDiagF(err, "Checking credentials for application with object ID %q", *app.Id)
+				}
+			}
+
+		case expiringCredentialOwnerTypeServicePrincipal:
+			resp, err := servicePrincipalClient.ListServicePrincipals(ctx, serviceprincipal.ListServicePrincipalsOperationOptions{Select: &fieldsToSelect})
+			if err != nil {
+				return tf.ErrorDiagF(err, "Could not retrieve service principals")
+			}
+			if resp.Model == nil {
+				return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+			}
+
+			for _, servicePrincipal := range *resp.Model {
+				if servicePrincipal.Id == nil {
+					return tf.ErrorDiagF(errors.New("API returned service principal with nil object ID"), "Bad API Response")
+				}
+				if err = appendResults(objectType, *servicePrincipal.Id, servicePrincipal.DisplayName.GetOrZero(), servicePrincipal.AppId.GetOrZero(), servicePrincipal.PasswordCredentials, servicePrincipal.KeyCredentials); err != nil {
+					return tf.ErrorDiagF(err, "Checking credentials for service principal with object ID %q", *servicePrincipal.Id)
+				}
+			}
+		}
+	}
+
+	// Order by soonest expiry, so that the most urgent credentials are listed first
+	sort.SliceStable(results, func(i, j int) bool {
+		if !results[i].EndDateTime.Equal(results[j].EndDateTime) {
+			return results[i].EndDateTime.Before(results[j].EndDateTime)
+		}
+		return results[i].KeyId < results[j].KeyId
+	})
+
+	ids := make([]string, 0)
+	credentialList := make([]map[string]interface{}, 0)
+	for _, result := range results {
+		ids = append(ids, fmt.Sprintf("%s/%s", result.OwnerObjectId, result.KeyId))
+
+		startDate := ""
+		if result.StartDateTime != nil {
+			startDate = result.StartDateTime.Format(time.RFC3339)
+		}
+
+		credentialList = append(credentialList, map[string]interface{}{
+			"client_id":          result.ClientId,
+			"display_name":       result.DisplayName,
+			"end_date":           result.EndDateTime.Format(time.RFC3339),
+			"expired":            result.Expired(now),
+			"key_id":             result.KeyId,
+			"owner_display_name": result.OwnerDisplayName,
+			"owner_object_id":    result.OwnerObjectId,
+			"owner_type":         result.OwnerType,
+			"start_date":         startDate,
+			"type":               result.Type,
+			"usage":              result.Usage,
+		})
+	}
+
+	// Generate a unique ID based on the window and result
+	h := sha1.New()
+	if _, err := h.Write([]byte(d.Get("expires_within").(string) + "/" + strings.Join(ids, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for credential IDs")
+	}
+
+	d.SetId("expiringcredentials#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "credentials", credentialList)
+
+	return nil
+}
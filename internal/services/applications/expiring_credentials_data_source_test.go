@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ExpiringCredentialsDataSource struct{}
+
+func TestAccExpiringCredentialsDataSource_applications(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_expiring_credentials", "test")
+	endDate := time.Now().AddDate(0, 0, 7).UTC().Format(time.RFC3339)
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ExpiringCredentialsDataSource{}.applications(data, endDate),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("credentials.#").Exists(),
+			// Other credentials in the tenant may also be expiring, so only the test application's credential is checked
+			acceptance.TestCheckTypeSetElemNestedAttrs(data.ResourceName, "credentials.*", map[string]string{
+				"display_name": fmt.Sprintf("acctest-%s", data.RandomString),
+				"expired":      "false",
+				"owner_type":   "Application",
+			}),
+			acceptance.TestCheckTypeSetElemAttrPair(data.ResourceName, "credentials.*.owner_object_id", "azuread_application.test", "object_id"),
+			acceptance.TestCheckTypeSetElemAttrPair(data.ResourceName, "credentials.*.key_id", "azuread_application_password.test", "key_id"),
+		),
+	}})
+}
+
+func TestAccExpiringCredentialsDataSource_all(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_expiring_credentials", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ExpiringCredentialsDataSource{}.all(),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("credentials.#").Exists(),
+		),
+	}})
+}
+
+func (ExpiringCredentialsDataSource) applications(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestExpiringCredentials-%[1]d"
+}
+
+resource "azuread_application_password" "test" {
+  application_id = azuread_application.test.id
+  display_name   = "acctest-%[2]s"
+  end_date       = "%[3]s"
+}
+
+data "azuread_expiring_credentials" "test" {
+  expires_within = "240h"
+  object_types   = ["Application"]
+
+  depends_on = [azuread_application_password.test]
+}
+`, data.RandomInteger, data.RandomString, endDate)
+}
+
+func (ExpiringCredentialsDataSource) all() string {
+	return `
+data "azuread_expiring_credentials" "test" {
+  expires_within  = "720h"
+  include_expired = true
+}
+`
+}
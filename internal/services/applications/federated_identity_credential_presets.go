@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/applications/validate"
+)
+
+// federatedIdentityCredentialPreset describes a well-known workload identity issuer, so that issuer-specific constraints
+// can be checked at plan time rather than being rejected by the API at apply time.
+type federatedIdentityCredentialPreset struct {
+	Name string
+
+	// Issuer matches the `iss` claim for this identity provider
+	Issuer *regexp.Regexp
+
+	// Subject matches the format of the `sub` claim for this identity provider. Nil when the format can be customized.
+	Subject *regexp.Regexp
+
+	// Claims lists the claims that may be referenced in a claims matching expression. When empty, flexible federated
+	// identity credentials are not supported for this issuer.
+	Claims []string
+}
+
+var federatedIdentityCredentialPresets = []federatedIdentityCredentialPreset{
+	{
+		// GitHub subject claims can be customized per organization or repository, so the format is not checked
+		Name:   "GitHub Actions",
+		Issuer: regexp.MustCompile(`^https://token\.actions\.githubusercontent\.com(/[^/]+)?/?$`),
+		Claims: []string{"sub", "job_workflow_ref"},
+	},
+	{
+		Name:    "Terraform Cloud",
+		Issuer:  regexp.MustCompile(`^https://app\.terraform\.io/?$`),
+		Subject: regexp.MustCompile(`^organization:[^:]+:project:[^:]+:workspace:[^:]+:run_phase:(plan|apply)$`),
+		Claims:  []string{"sub"},
+	},
+	{
+		Name:    "Azure Kubernetes Service",
+		Issuer:  regexp.MustCompile(`^https://[a-z0-9-]+\.oic\.prod-aks\.azure\.com/[0-9a-fA-F-]{36}/[0-9a-fA-F-]{36}/?$`),
+		Subject: regexp.MustCompile(`^system:serviceaccount:[^:]+:[^:]+$`),
+	},
+}
+
+// federatedIdentityCredentialPresetForIssuer returns the preset matching the given issuer, or nil when the issuer is not
+// a well-known identity provider
+func federatedIdentityCredentialPresetForIssuer(issuer string) *federatedIdentityCredentialPreset {
+	for i := range federatedIdentityCredentialPresets {
+		if federatedIdentityCredentialPresets[i].Issuer.MatchString(issuer) {
+			return &federatedIdentityCredentialPresets[i]
+		}
+	}
+	return nil
+}
+
+// ValidateSubject returns an error when the subject does not match the expected format for this identity provider
+func (p federatedIdentityCredentialPreset) ValidateSubject(subject string) error {
+	if p.Subject != nil && !p.Subject.MatchString(subject) {
+		return fmt.Errorf("subject %q does not match the expected format for %s (%s)", subject, p.Name, p.Subject.String())
+	}
+	return nil
+}
+
+// ValidateClaimsMatchingExpression returns an error when the expression references claims not supported by this
+// identity provider, or when this identity provider does not support flexible federated identity credentials
+func (p federatedIdentityCredentialPreset) ValidateClaimsMatchingExpression(expression string) error {
+	if len(p.Claims) == 0 {
+		return fmt.Errorf("claims matching expressions are not supported for %s issuers", p.Name)
+	}
+
+	conditions, err := validate.ParseClaimsMatchingExpression(expression)
+	if err != nil {
+		return err
+	}
+
+	for _, condition := range conditions {
+		supported := false
+		for _, claim := range p.Claims {
+			if condition.Claim == claim {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("claim %q is not supported in claims matching expressions for %s issuers, supported claims are: %s", condition.Claim, p.Name, strings.Join(p.Claims, ", "))
+		}
+	}
+
+	return nil
+}
+
+const federatedIdentityCredentialDefaultAudience = "api://AzureADTokenExchange"
+
+// federatedIdentityCredentialPresetBlock is a schema block which generates the `issuer` and `subject` of a federated
+// identity credential for a well-known identity provider
+type federatedIdentityCredentialPresetBlock struct {
+	Description string
+	Schema      map[string]*pluginsdk.Schema
+
+	// Expand returns the issuer and subject for the block. The subject is empty when the block does not specify a
+	// workload, in which case a claims matching expression should be used.
+	Expand func(in map[string]interface{}) (issuer string, subject string, err error)
+
+	// Flatten parses an issuer and subject back into the block, returning nil when they were not generated by it. The
+	// existing block is used for values which cannot be derived, such as when a claims matching expression is used.
+	Flatten func(issuer, subject string, existing map[string]interface{}) map[string]interface{}
+}
+
+var (
+	federatedIdentityCredentialGitHubIssuer         = "https://token.actions.githubusercontent.com"
+	federatedIdentityCredentialGitHubSubjectRegex   = regexp.MustCompile(`^repo:([^/:]+)/([^/:]+):(environment:(.+)|ref:refs/heads/(.+)|ref:refs/tags/(.+)|pull_request)$`)
+	federatedIdentityCredentialTerraformCloudIssuer = "https://app.terraform.io"
+	federatedIdentityCredentialTerraformCloudRegex  = regexp.MustCompile(`^organization:([^:]+):project:([^:]+):workspace:([^:]+):run_phase:(plan|apply)$`)
+	federatedIdentityCredentialKubernetesRegex      = regexp.MustCompile(`^system:serviceaccount:([^:]+):([^:]+)$`)
+)
+
+var federatedIdentityCredentialPresetBlocks = map[string]federatedIdentityCredentialPresetBlock{
+	"github": {
+		Description: "Generates the `issuer` and `subject` for a GitHub Actions workflow",
+		Schema: map[string]*pluginsdk.Schema{
+			"organization": {
+				Description:  "The GitHub organization or user which owns the repository",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"repository": {
+				Description:  "The name of the GitHub repository",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"entity_type": {
+				Description:  "The type of entity for which tokens should be accepted, one of `environment`, `branch`, `tag` or `pull_request`. When omitted, a `claims_matching_expression` should be specified.",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"environment", "branch", "tag", "pull_request"}, false),
+			},
+
+			"value": {
+				Description: "The name of the environment, branch or tag. Required unless `entity_type` is `pull_request`.",
+				Type:        pluginsdk.TypeString,
+				Optional:    true,
+			},
+		},
+		Expand: func(in map[string]interface{}) (string, string, error) {
+			repo := fmt.Sprintf("repo:%s/%s", in["organization"].(string), in["repository"].(string))
+			entityType, value := in["entity_type"].(string), in["value"].(string)
+
+			if entityType != "" && entityType != "pull_request" && value == "" {
+				return "", "", fmt.Errorf("`value` must be specified when `entity_type` is %q", entityType)
+			}
+			if (entityType == "" || entityType == "pull_request") && value != "" {
+				return "", "", fmt.Errorf("`value` cannot be specified when `entity_type` is %q", entityType)
+			}
+
+			switch entityType {
+			case "environment":
+				return federatedIdentityCredentialGitHubIssuer, fmt.Sprintf("%s:environment:%s", repo, value), nil
+			case "branch":
+				return federatedIdentityCredentialGitHubIssuer, fmt.Sprintf("%s:ref:refs/heads/%s", repo, value), nil
+			case "tag":
+				return federatedIdentityCredentialGitHubIssuer, fmt.Sprintf("%s:ref:refs/tags/%s", repo, value), nil
+			case "pull_request":
+				return federatedIdentityCredentialGitHubIssuer, fmt.Sprintf("%s:pull_request", repo), nil
+			}
+
+			return federatedIdentityCredentialGitHubIssuer, "", nil
+		},
+		Flatten: func(issuer, subject string, existing map[string]interface{}) map[string]interface{} {
+			if strings.TrimSuffix(issuer, "/") != federatedIdentityCredentialGitHubIssuer {
+				return nil
+			}
+			if subject == "" {
+				return existing
+			}
+
+			m := federatedIdentityCredentialGitHubSubjectRegex.FindStringSubmatch(subject)
+			if m == nil {
+				return nil
+			}
+
+			result := map[string]interface{}{
+				"organization": m[1],
+				"repository":   m[2],
+				"entity_type":  "pull_request",
+				"value":        "",
+			}
+			switch {
+			case m[4] != "":
+				result["entity_type"], result["value"] = "environment", m[4]
+			case m[5] != "":
+				result["entity_type"], result["value"] = "branch", m[5]
+			case m[6] != "":
+				result["entity_type"], result["value"] = "tag", m[6]
+			}
+
+			return result
+		},
+	},
+
+	"kubernetes": {
+		Description: "Generates the `subject` for a Kubernetes service account, such as in an Azure Kubernetes Service cluster",
+		Schema: map[string]*pluginsdk.Schema{
+			"oidc_issuer_url": {
+				Description:  "The OIDC issuer URL of the cluster",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsHttpsUrl,
+			},
+
+			"namespace": {
+				Description:  "The namespace of the service account",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"service_account_name": {
+				Description:  "The name of the service account",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		Expand: func(in map[string]interface{}) (string, string, error) {
+			return in["oidc_issuer_url"].(string), fmt.Sprintf("system:serviceaccount:%s:%s", in["namespace"].(string), in["service_account_name"].(string)), nil
+		},
+		Flatten: func(issuer, subject string, _ map[string]interface{}) map[string]interface{} {
+			m := federatedIdentityCredentialKubernetesRegex.FindStringSubmatch(subject)
+			if m == nil {
+				return nil
+			}
+
+			return map[string]interface{}{
+				"oidc_issuer_url":      issuer,
+				"namespace":            m[1],
+				"service_account_name": m[2],
+			}
+		},
+	},
+
+	"terraform_cloud": {
+		Description: "Generates the `issuer` and `subject` for a Terraform Cloud workspace",
+		Schema: map[string]*pluginsdk.Schema{
+			"organization": {
+				Description:  "The name of the Terraform Cloud organization",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"project": {
+				Description:  "The name of the project containing the workspace",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"workspace": {
+				Description:  "The name of the workspace",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"run_phase": {
+				Description:  "The run phase for which tokens should be accepted, either `plan` or `apply`",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"plan", "apply"}, false),
+			},
+		},
+		Expand: func(in map[string]interface{}) (string, string, error) {
+			return federatedIdentityCredentialTerraformCloudIssuer, fmt.Sprintf("organization:%s:project:%s:workspace:%s:run_phase:%s", in["organization"].(string), in["project"].(string), in["workspace"].(string), in["run_phase"].(string)), nil
+		},
+		Flatten: func(issuer, subject string, _ map[string]interface{}) map[string]interface{} {
+			if strings.TrimSuffix(issuer, "/") != federatedIdentityCredentialTerraformCloudIssuer {
+				return nil
+			}
+
+			m := federatedIdentityCredentialTerraformCloudRegex.FindStringSubmatch(subject)
+			if m == nil {
+				return nil
+			}
+
+			return map[string]interface{}{
+				"organization": m[1],
+				"project":      m[2],
+				"workspace":    m[3],
+				"run_phase":    m[4],
+			}
+		},
+	},
+}
+
+// federatedIdentityCredentialPresetBlockNames returns the names of all preset blocks, for use in schema constraints
+func federatedIdentityCredentialPresetBlockNames() []string {
+	return []string{"github", "kubernetes", "terraform_cloud"}
+}
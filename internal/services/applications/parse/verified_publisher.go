@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+type VerifiedPublisherId struct {
+	ApplicationId string
+}
+
+func NewVerifiedPublisherID(applicationId string) *VerifiedPublisherId {
+	return &VerifiedPublisherId{
+		ApplicationId: applicationId,
+	}
+}
+
+// ParseVerifiedPublisherID parses 'input' into a VerifiedPublisherId
+func ParseVerifiedPublisherID(input string) (*VerifiedPublisherId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&VerifiedPublisherId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := &VerifiedPublisherId{}
+
+	if id.ApplicationId, ok = parsed.Parsed["applicationId"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "applicationId", *parsed)
+	}
+
+	return id, nil
+}
+
+// ValidateVerifiedPublisherID checks that 'input' can be parsed as an Application ID
+func ValidateVerifiedPublisherID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := ParseVerifiedPublisherID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	return validation.IsUUID(id.ApplicationId, "ID")
+}
+
+func (id *VerifiedPublisherId) ID() string {
+	fmtString := "/applications/%s/verifiedPublisher"
+	return fmt.Sprintf(fmtString, id.ApplicationId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id *VerifiedPublisherId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("applications", "applications", "applications"),
+		resourceids.UserSpecifiedSegment("applicationId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("verifiedPublisher", "verifiedPublisher", "verifiedPublisher"),
+	}
+}
+
+func (id *VerifiedPublisherId) String() string {
+	return fmt.Sprintf("Verified Publisher (Application ID: %q)", id.ApplicationId)
+}
+
+func (id *VerifiedPublisherId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.ApplicationId, ok = input.Parsed["applicationId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationId", input)
+	}
+
+	return nil
+}
@@ -32,8 +32,10 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azuread_application":                   applicationDataSource(),
+		"azuread_applications":                  applicationsDataSource(),
 		"azuread_application_published_app_ids": applicationPublishedAppIdsDataSource(),
 		"azuread_application_template":          applicationTemplateDataSource(),
+		"azuread_expiring_credentials":          expiringCredentialsDataSource(),
 	}
 }
 
@@ -56,6 +58,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ApplicationAdminConsentResource{},
 		ApplicationApiAccessResource{},
 		ApplicationAppRoleResource{},
 		ApplicationFallbackPublicClientResource{},
@@ -67,5 +70,6 @@ func (r Registration) Resources() []sdk.Resource {
 		ApplicationPermissionScopeResource{},
 		ApplicationRedirectUrisResource{},
 		ApplicationRegistrationResource{},
+		ApplicationVerifiedPublisherResource{},
 	}
 }
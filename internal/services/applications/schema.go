@@ -65,3 +65,45 @@ func schemaOptionalClaims() *pluginsdk.Schema {
 		},
 	}
 }
+
+func schemaServicePrincipalLock() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: "Configures locking of sensitive properties on service principals created from this application in other tenants",
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"enabled": {
+					Description: "Whether the service principal lock configuration is enabled",
+					Type:        pluginsdk.TypeBool,
+					Required:    true,
+				},
+
+				"all_properties": {
+					Description: "Whether all sensitive properties (`keyCredentials`, `passwordCredentials` and `tokenEncryptionKeyId`) are locked",
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+				},
+
+				"credentials_with_usage_sign": {
+					Description: "Whether key and password credentials with a usage type of `Sign` are locked",
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+				},
+
+				"credentials_with_usage_verify": {
+					Description: "Whether key and password credentials with a usage type of `Verify` are locked",
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+				},
+
+				"token_encryption_key_id": {
+					Description: "Whether the `tokenEncryptionKeyId` property is locked",
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
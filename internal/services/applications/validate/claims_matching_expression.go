@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+// ClaimsMatchingCondition is a single comparison within a claims matching expression, e.g. `claims['sub'] eq 'foo'`
+type ClaimsMatchingCondition struct {
+	Claim    string
+	Operator string
+	Value    string
+}
+
+const (
+	ClaimsMatchingOperatorEq      = "eq"
+	ClaimsMatchingOperatorMatches = "matches"
+)
+
+// ParseClaimsMatchingExpression parses a flexible federated identity credential expression (language version 1) and
+// returns the conditions that it contains. The grammar is:
+//
+//	expression = condition { "and" condition }
+//	condition  = "claims" "[" string "]" ( "eq" | "matches" ) string
+//	string     = "'" { character | "''" } "'"
+//
+// Errors include the 1-based character position at which parsing failed.
+// See https://learn.microsoft.com/en-us/entra/workload-id/workload-identities-flexible-federated-identity-credentials
+func ParseClaimsMatchingExpression(input string) ([]ClaimsMatchingCondition, error) {
+	p := &claimsMatchingExpressionParser{input: []rune(input)}
+
+	conditions := make([]ClaimsMatchingCondition, 0)
+	for {
+		condition, err := p.condition()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, *condition)
+
+		p.skipSpace()
+		if p.eof() {
+			break
+		}
+
+		start := p.pos
+		if word := p.word(); word != "and" {
+			return nil, p.errorAt(start, "expected `and` or end of expression, found %s", p.describe(word))
+		}
+	}
+
+	return conditions, nil
+}
+
+// ClaimsMatchingExpression validates that a value is a syntactically correct claims matching expression
+func ClaimsMatchingExpression(i interface{}, path cty.Path) (ret pluginsdk.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expression must not be empty",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if _, err := ParseClaimsMatchingExpression(v); err != nil {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid claims matching expression",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return
+}
+
+type claimsMatchingExpressionParser struct {
+	input []rune
+	pos   int
+}
+
+func (p *claimsMatchingExpressionParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *claimsMatchingExpressionParser) errorAt(pos int, format string, a ...interface{}) error {
+	return fmt.Errorf("at position %d: %s", pos+1, fmt.Sprintf(format, a...))
+}
+
+// describe returns a human-readable description of the token that was found at the current position
+func (p *claimsMatchingExpressionParser) describe(word string) string {
+	switch {
+	case word != "":
+		return fmt.Sprintf("%q", word)
+	case p.eof():
+		return "end of expression"
+	default:
+		return fmt.Sprintf("%q", p.input[p.pos])
+	}
+}
+
+func (p *claimsMatchingExpressionParser) skipSpace() {
+	for !p.eof() && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *claimsMatchingExpressionParser) word() string {
+	p.skipSpace()
+	start := p.pos
+	for !p.eof() && (unicode.IsLetter(p.input[p.pos]) || unicode.IsDigit(p.input[p.pos]) || p.input[p.pos] == '_') {
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+func (p *claimsMatchingExpressionParser) expect(r rune) error {
+	p.skipSpace()
+	if p.eof() {
+		return p.errorAt(p.pos, "expected %q, found end of expression", r)
+	}
+	if p.input[p.pos] != r {
+		return p.errorAt(p.pos, "expected %q, found %q", r, p.input[p.pos])
+	}
+	p.pos++
+	return nil
+}
+
+func (p *claimsMatchingExpressionParser) str() (string, error) {
+	if err := p.expect('\''); err != nil {
+		return "", err
+	}
+	start := p.pos - 1
+
+	var sb strings.Builder
+	for !p.eof() {
+		r := p.input[p.pos]
+		p.pos++
+		if r == '\'' {
+			// A doubled quote is an escaped literal quote
+			if !p.eof() && p.input[p.pos] == '\'' {
+				sb.WriteRune('\'')
+				p.pos++
+				continue
+			}
+			return sb.String(), nil
+		}
+		sb.WriteRune(r)
+	}
+
+	return "", p.errorAt(start, "unterminated string literal")
+}
+
+func (p *claimsMatchingExpressionParser) condition() (*ClaimsMatchingCondition, error) {
+	p.skipSpace()
+	start := p.pos
+	if word := p.word(); word != "claims" {
+		return nil, p.errorAt(start, "expected `claims`, found %s", p.describe(word))
+	}
+
+	if err := p.expect('['); err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	claimPos := p.pos
+	claim, err := p.str()
+	if err != nil {
+		return nil, err
+	}
+	if claim == "" {
+		return nil, p.errorAt(claimPos, "claim name must not be empty")
+	}
+
+	if err = p.expect(']'); err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	opPos := p.pos
+	operator := p.word()
+	switch operator {
+	case ClaimsMatchingOperatorEq, ClaimsMatchingOperatorMatches:
+	case "":
+		return nil, p.errorAt(opPos, "expected `eq` or `matches`, found %s", p.describe(operator))
+	default:
+		return nil, p.errorAt(opPos, "unsupported operator %q, expected `eq` or `matches`", operator)
+	}
+
+	p.skipSpace()
+	value, err := p.str()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClaimsMatchingCondition{
+		Claim:    claim,
+		Operator: operator,
+		Value:    value,
+	}, nil
+}
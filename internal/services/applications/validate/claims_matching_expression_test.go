@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestClaimsMatchingExpression(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    "claims['sub'] matches 'repo:contoso/contoso-repo:ref:refs/heads/*'",
+			TestName: "Valid_Matches",
+			ErrCount: 0,
+		},
+		{
+			Value:    "claims['sub'] eq 'repo:contoso/contoso-repo:environment:prod'",
+			TestName: "Valid_Eq",
+			ErrCount: 0,
+		},
+		{
+			Value:    "claims['sub'] matches 'repo:contoso/*' and claims['job_workflow_ref'] eq 'contoso/workflows/.github/workflows/deploy.yml@refs/heads/main'",
+			TestName: "Valid_And",
+			ErrCount: 0,
+		},
+		{
+			Value:    "  claims [ 'sub' ]  eq  'it''s quoted'  ",
+			TestName: "Valid_WhitespaceAndEscapedQuote",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			TestName: "Invalid_Empty",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claim['sub'] eq 'foo'",
+			TestName: "Invalid_Keyword",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims['sub'] ne 'foo'",
+			TestName: "Invalid_Operator",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims['sub'] eq 'foo' or claims['sub'] eq 'bar'",
+			TestName: "Invalid_Or",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims['sub'] eq 'foo",
+			TestName: "Invalid_UnterminatedString",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims[''] eq 'foo'",
+			TestName: "Invalid_EmptyClaim",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims['sub'] eq 'foo' and",
+			TestName: "Invalid_TrailingAnd",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims.sub eq 'foo'",
+			TestName: "Invalid_DotAccessor",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			diags := ClaimsMatchingExpression(tc.Value, cty.Path{})
+
+			if len(diags) != tc.ErrCount {
+				t.Fatalf("Expected ClaimsMatchingExpression to have %d not %d errors for %q", tc.ErrCount, len(diags), tc.TestName)
+			}
+		})
+	}
+}
+
+func TestParseClaimsMatchingExpression(t *testing.T) {
+	conditions, err := ParseClaimsMatchingExpression("claims['sub'] matches 'repo:contoso/*' and claims['job_workflow_ref'] eq 'it''s'")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	expected := []ClaimsMatchingCondition{
+		{Claim: "sub", Operator: ClaimsMatchingOperatorMatches, Value: "repo:contoso/*"},
+		{Claim: "job_workflow_ref", Operator: ClaimsMatchingOperatorEq, Value: "it's"},
+	}
+	if len(conditions) != len(expected) {
+		t.Fatalf("expected %d conditions, got %d", len(expected), len(conditions))
+	}
+	for i := range expected {
+		if conditions[i] != expected[i] {
+			t.Fatalf("condition %d: expected %+v, got %+v", i, expected[i], conditions[i])
+		}
+	}
+}
+
+func TestParseClaimsMatchingExpressionErrorPosition(t *testing.T) {
+	_, err := ParseClaimsMatchingExpression("claims['sub'] is 'foo'")
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), "at position 15") {
+		t.Fatalf("expected error to reference position 15, got: %v", err)
+	}
+}
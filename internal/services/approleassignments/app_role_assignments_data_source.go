@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func appRoleAssignmentsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: appRoleAssignmentsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_object_id": {
+				Description:  "The object ID of the service principal representing the resource, for which to list app role assignments",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"principal_object_id", "resource_object_id"},
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"principal_object_id": {
+				Description:  "The object ID of the user, group or service principal for which to list app role assignments",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"principal_object_id", "resource_object_id"},
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"app_role_ids": {
+				Description:   "A set of app role IDs by which to filter the app role assignments",
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"app_role_values"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"app_role_values": {
+				Description:   "A set of app role values by which to filter the app role assignments",
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"app_role_ids"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"app_role_assignments": {
+				Description: "A list of app role assignments",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The ID of the app role assignment",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"app_role_id": {
+							Description: "The ID of the assigned app role",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"app_role_value": {
+							Description: "The value of the assigned app role",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"created_date_time": {
+							Description: "The time when the app role assignment was created",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"principal_display_name": {
+							Description: "The display name of the principal to which the app role is assigned",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"principal_object_id": {
+							Description: "The object ID of the principal to which the app role is assigned",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"principal_type": {
+							Description: "The object type of the principal to which the app role is assigned",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"resource_display_name": {
+							Description: "The display name of the application representing the resource",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"resource_object_id": {
+							Description: "The object ID of the service principal representing the resource",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func appRoleAssignmentsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AppRoleAssignments
+	appRoles := newAppRoleAssignmentResourceAppRoles(client.ServicePrincipalClient)
+
+	resourceId := d.Get("resource_object_id").(string)
+	principalId := d.Get("principal_object_id").(string)
+
+	appRoleIds := make(map[string]bool)
+	for _, v := range d.Get("app_role_ids").(*pluginsdk.Set).List() {
+		appRoleIds[strings.ToLower(v.(string))] = true
+	}
+
+	appRoleValues := make(map[string]bool)
+	for _, v := range d.Get("app_role_values").(*pluginsdk.Set).List() {
+		appRoleValues[v.(string)] = true
+	}
+
+	// When listing assignments for a resource, all app role values must be exposed by that resource
+	if resourceId != "" && len(appRoleValues) > 0 {
+		resourceAppRoleIds, err := appRoles.AppRoleIDs(ctx, resourceId)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "resource_object_id", "Retrieving app roles for resource service principal")
+		}
+		for value := range appRoleValues {
+			if _, ok := resourceAppRoleIds[value]; !ok {
+				return tf.ErrorDiagPathF(nil, "app_role_values", "App role with value %q was not found for resource service principal with object ID %q", value, resourceId)
+			}
+		}
+	}
+
+	appRoleAssignments, err := listAppRoleAssignments(ctx, client, resourceId, principalId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing app role assignments")
+	}
+
+	result := make([]map[string]interface{}, 0)
+	assignmentIds := make([]string, 0)
+
+	for _, appRoleAssignment := range appRoleAssignments {
+		appRoleId := pointer.From(appRoleAssignment.AppRoleId)
+		if len(appRoleIds) > 0 && !appRoleIds[strings.ToLower(appRoleId)] {
+			continue
+		}
+
+		appRoleValue, err := appRoles.AppRoleValue(ctx, appRoleAssignment.ResourceId.GetOrZero(), appRoleId)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving app roles for resource service principal")
+		}
+		if len(appRoleValues) > 0 && !appRoleValues[appRoleValue] {
+			continue
+		}
+
+		assignmentIds = append(assignmentIds, pointer.From(appRoleAssignment.Id))
+		result = append(result, map[string]interface{}{
+			"id":                     pointer.From(appRoleAssignment.Id),
+			"app_role_id":            appRoleId,
+			"app_role_value":         appRoleValue,
+			"created_date_time":      appRoleAssignment.CreatedDateTime.GetOrZero(),
+			"principal_display_name": appRoleAssignment.PrincipalDisplayName.GetOrZero(),
+			"principal_object_id":    appRoleAssignment.PrincipalId.GetOrZero(),
+			"principal_type":         appRoleAssignment.PrincipalType.GetOrZero(),
+			"resource_display_name":  appRoleAssignment.ResourceDisplayName.GetOrZero(),
+			"resource_object_id":     appRoleAssignment.ResourceId.GetOrZero(),
+		})
+	}
+
+	// Generate a unique ID based on the queried object and result
+	sort.Strings(assignmentIds)
+	h := sha1.New()
+	if _, err := h.Write([]byte(fmt.Sprintf("%s/%s/%s", resourceId, principalId, strings.Join(assignmentIds, "/")))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for app role assignment IDs")
+	}
+
+	d.SetId("approleassignments#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "app_role_assignments", result)
+
+	return nil
+}
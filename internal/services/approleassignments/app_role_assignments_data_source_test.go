@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type AppRoleAssignmentsDataSource struct{}
+
+func TestAccAppRoleAssignmentsDataSource_byResource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_app_role_assignments", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: AppRoleAssignmentsDataSource{}.byResource(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("app_role_assignments.#").HasValue("2"),
+			check.That(data.ResourceName).Key("app_role_assignments.0.principal_type").HasValue("ServicePrincipal"),
+			check.That(data.ResourceName).Key("app_role_assignments.0.principal_display_name").Exists(),
+		),
+	}})
+}
+
+func TestAccAppRoleAssignmentsDataSource_byResourceWithAppRoleValues(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_app_role_assignments", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: AppRoleAssignmentsDataSource{}.byResourceWithAppRoleValues(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("app_role_assignments.#").HasValue("1"),
+			check.That(data.ResourceName).Key("app_role_assignments.0.app_role_value").HasValue("Admin.All"),
+			check.That(data.ResourceName).Key("app_role_assignments.0.app_role_id").MatchesOtherKey(check.That("azuread_app_role_assignment.test_admin").Key("app_role_id")),
+		),
+	}})
+}
+
+func TestAccAppRoleAssignmentsDataSource_byPrincipalWithAppRoleIds(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_app_role_assignments", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: AppRoleAssignmentsDataSource{}.byPrincipalWithAppRoleIds(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("app_role_assignments.#").HasValue("1"),
+			check.That(data.ResourceName).Key("app_role_assignments.0.app_role_value").HasValue("Query.All"),
+			check.That(data.ResourceName).Key("app_role_assignments.0.resource_object_id").MatchesOtherKey(check.That("azuread_service_principal.internal").Key("object_id")),
+		),
+	}})
+}
+
+func (AppRoleAssignmentsDataSource) byResource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_app_role_assignments" "test" {
+  resource_object_id = azuread_service_principal.internal.object_id
+
+  depends_on = [
+    azuread_app_role_assignment.test_admin,
+    azuread_app_role_assignment.test_query,
+  ]
+}
+`, AppRoleAssignmentResource{}.servicePrincipalForTenantApp(data))
+}
+
+func (AppRoleAssignmentsDataSource) byResourceWithAppRoleValues(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_app_role_assignments" "test" {
+  resource_object_id = azuread_service_principal.internal.object_id
+  app_role_values    = ["Admin.All"]
+
+  depends_on = [
+    azuread_app_role_assignment.test_admin,
+    azuread_app_role_assignment.test_query,
+  ]
+}
+`, AppRoleAssignmentResource{}.servicePrincipalForTenantApp(data))
+}
+
+func (AppRoleAssignmentsDataSource) byPrincipalWithAppRoleIds(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_app_role_assignments" "test" {
+  principal_object_id = azuread_service_principal.test.object_id
+  app_role_ids        = [azuread_service_principal.internal.app_role_ids["Query.All"]]
+
+  depends_on = [
+    azuread_app_role_assignment.test_admin,
+    azuread_app_role_assignment.test_query,
+  ]
+}
+`, AppRoleAssignmentResource{}.servicePrincipalForTenantApp(data))
+}
@@ -0,0 +1,439 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignedto"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/approleassignments/client"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/approleassignments/parse"
+)
+
+const (
+	appRoleAssignmentsResourceName = "azuread_app_role_assignments"
+	defaultAppRoleId               = "00000000-0000-0000-0000-000000000000"
+)
+
+func appRoleAssignmentsResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: appRoleAssignmentsResourceCreate,
+		ReadContext:   appRoleAssignmentsResourceRead,
+		UpdateContext: appRoleAssignmentsResourceUpdate,
+		DeleteContext: appRoleAssignmentsResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ParseAppRoleAssignmentsID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_object_id": {
+				Description:  "The object ID of the service principal representing the resource",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"app_role_id": {
+				Description:   "The ID of the app role to be assigned. When omitted, assignments for all app roles are managed",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsUUID,
+				ConflictsWith: []string{"assignment"},
+			},
+
+			"principal_object_ids": {
+				Description:  "A set of object IDs of the users, groups or service principals to be assigned the app role, replacing any existing assignments",
+				Type:         pluginsdk.TypeSet,
+				Optional:     true,
+				Set:          pluginsdk.HashString,
+				RequiredWith: []string{"app_role_id"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"assignment": {
+				Description:   "A set of app role assignments, replacing any existing assignments for all app roles",
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"app_role_id", "principal_object_ids"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"app_role_id": {
+							Description:  "The ID of the app role to be assigned",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"principal_object_id": {
+							Description:  "The object ID of the user, group or service principal to be assigned",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+
+			"resource_display_name": {
+				Description: "The display name of the application representing the resource",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func appRoleAssignmentsResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AppRoleAssignments
+
+	resourceId := d.Get("resource_object_id").(string)
+	appRoleId := d.Get("app_role_id").(string)
+	id := parse.NewAppRoleAssignmentsID(resourceId, appRoleId)
+
+	resp, err := client.ServicePrincipalClient.GetServicePrincipal(ctx, stable.NewServicePrincipalID(resourceId), serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(err, "resource_object_id", "Service principal not found for resource (Object ID: %q)", resourceId)
+		}
+		return tf.ErrorDiagF(err, "Could not retrieve service principal for resource (Object ID: %q)", resourceId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Could not retrieve service principal for resource (Object ID: %q)", resourceId)
+	}
+
+	desired := expandAppRoleAssignments(d, id)
+	if err = appRoleAssignmentsValidateAppRoles(pointer.From(resp.Model.AppRoles), desired); err != nil {
+		return tf.ErrorDiagF(err, "Validating app roles for resource service principal with object ID %q", resourceId)
+	}
+
+	tf.LockByName(appRoleAssignmentsResourceName, resourceId)
+	defer tf.UnlockByName(appRoleAssignmentsResourceName, resourceId)
+
+	d.SetId(id.ID())
+
+	if err = appRoleAssignmentsReconcile(ctx, client, id, desired); err != nil {
+		return tf.ErrorDiagF(err, "Assigning app roles for %s", id)
+	}
+
+	return appRoleAssignmentsResourceRead(ctx, d, meta)
+}
+
+func appRoleAssignmentsResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AppRoleAssignments
+
+	id, err := parse.ParseAppRoleAssignmentsID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing App Role Assignments ID")
+	}
+
+	tf.LockByName(appRoleAssignmentsResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(appRoleAssignmentsResourceName, id.ServicePrincipalId)
+
+	if d.HasChanges("assignment", "principal_object_ids") {
+		servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+		resp, err := client.ServicePrincipalClient.GetServicePrincipal(ctx, servicePrincipalId, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving %s", servicePrincipalId)
+		}
+		if resp.Model == nil {
+			return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", servicePrincipalId)
+		}
+
+		desired := expandAppRoleAssignments(d, *id)
+		if err = appRoleAssignmentsValidateAppRoles(pointer.From(resp.Model.AppRoles), desired); err != nil {
+			return tf.ErrorDiagF(err, "Validating app roles for %s", servicePrincipalId)
+		}
+
+		if err = appRoleAssignmentsReconcile(ctx, client, *id, desired); err != nil {
+			return tf.ErrorDiagF(err, "Assigning app roles for %s", id)
+		}
+	}
+
+	return appRoleAssignmentsResourceRead(ctx, d, meta)
+}
+
+func appRoleAssignmentsResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AppRoleAssignments
+
+	id, err := parse.ParseAppRoleAssignmentsID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing App Role Assignments ID")
+	}
+
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+
+	resp, err := client.ServicePrincipalClient.GetServicePrincipal(ctx, servicePrincipalId, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", servicePrincipalId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "retrieving %s", servicePrincipalId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "retrieving %s", servicePrincipalId)
+	}
+
+	appRoleAssignments, err := appRoleAssignmentsList(ctx, client, *id)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing app role assignments for %s", id)
+	}
+
+	// Assignments are tracked as principal and app role pairs, so that a principal being assigned a different app role
+	// is detected as drift
+	assignments := make([]interface{}, 0)
+	principalIds := make([]string, 0)
+	seen := make(map[appRoleAssignmentPair]bool)
+	for _, appRoleAssignment := range appRoleAssignments {
+		pair := flattenAppRoleAssignmentPair(appRoleAssignment)
+		if pair.principalId == "" || seen[pair.key()] {
+			continue
+		}
+		seen[pair.key()] = true
+
+		if id.AppRoleId == "" {
+			assignments = append(assignments, map[string]interface{}{
+				"app_role_id":         pair.appRoleId,
+				"principal_object_id": pair.principalId,
+			})
+		} else {
+			principalIds = append(principalIds, pair.principalId)
+		}
+	}
+
+	tf.Set(d, "app_role_id", id.AppRoleId)
+	tf.Set(d, "assignment", assignments)
+	tf.Set(d, "principal_object_ids", principalIds)
+	tf.Set(d, "resource_display_name", resp.Model.DisplayName.GetOrZero())
+	tf.Set(d, "resource_object_id", id.ServicePrincipalId)
+
+	return nil
+}
+
+func appRoleAssignmentsResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AppRoleAssignments
+
+	id, err := parse.ParseAppRoleAssignmentsID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing App Role Assignments ID")
+	}
+
+	tf.LockByName(appRoleAssignmentsResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(appRoleAssignmentsResourceName, id.ServicePrincipalId)
+
+	appRoleAssignments, err := appRoleAssignmentsList(ctx, client, *id)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing app role assignments for %s", id)
+	}
+
+	// Only remove the assignments managed by this resource
+	managed := make(map[appRoleAssignmentPair]bool)
+	for _, pair := range expandAppRoleAssignments(d, *id) {
+		managed[pair.key()] = true
+	}
+
+	for _, appRoleAssignment := range appRoleAssignments {
+		if !managed[flattenAppRoleAssignmentPair(appRoleAssignment).key()] {
+			continue
+		}
+		if err = appRoleAssignmentsRemove(ctx, client, *id, appRoleAssignment); err != nil {
+			return tf.ErrorDiagF(err, "Removing app role assignments for %s", id)
+		}
+	}
+
+	return nil
+}
+
+// appRoleAssignmentsList returns the app role assignments for the resource service principal, limited to the app
+// role in the ID when one is specified
+func appRoleAssignmentsList(ctx context.Context, c *client.Client, id parse.AppRoleAssignmentsId) ([]stable.AppRoleAssignment, error) {
+	appRoleAssignments, err := listAppRoleAssignments(ctx, c, id.ServicePrincipalId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if id.AppRoleId == "" {
+		return appRoleAssignments, nil
+	}
+
+	result := make([]stable.AppRoleAssignment, 0)
+	for _, appRoleAssignment := range appRoleAssignments {
+		if strings.EqualFold(pointer.From(appRoleAssignment.AppRoleId), id.AppRoleId) {
+			result = append(result, appRoleAssignment)
+		}
+	}
+
+	return result, nil
+}
+
+// appRoleAssignmentPair identifies an app role assignment by the assigned principal and app role
+type appRoleAssignmentPair struct {
+	principalId string
+	appRoleId   string
+}
+
+func (p appRoleAssignmentPair) key() appRoleAssignmentPair {
+	return appRoleAssignmentPair{
+		principalId: strings.ToLower(p.principalId),
+		appRoleId:   strings.ToLower(p.appRoleId),
+	}
+}
+
+func flattenAppRoleAssignmentPair(appRoleAssignment stable.AppRoleAssignment) appRoleAssignmentPair {
+	return appRoleAssignmentPair{
+		principalId: appRoleAssignment.PrincipalId.GetOrZero(),
+		appRoleId:   pointer.From(appRoleAssignment.AppRoleId),
+	}
+}
+
+// expandAppRoleAssignments returns the desired assignments, from `principal_object_ids` when managing a single app
+// role, or from the `assignment` blocks when managing all app roles
+func expandAppRoleAssignments(d *pluginsdk.ResourceData, id parse.AppRoleAssignmentsId) []appRoleAssignmentPair {
+	result := make([]appRoleAssignmentPair, 0)
+
+	if id.AppRoleId != "" {
+		for _, principalId := range tf.ExpandStringSlice(d.Get("principal_object_ids").(*pluginsdk.Set).List()) {
+			result = append(result, appRoleAssignmentPair{
+				principalId: principalId,
+				appRoleId:   id.AppRoleId,
+			})
+		}
+		return result
+	}
+
+	for _, raw := range d.Get("assignment").(*pluginsdk.Set).List() {
+		assignment := raw.(map[string]interface{})
+		result = append(result, appRoleAssignmentPair{
+			principalId: assignment["principal_object_id"].(string),
+			appRoleId:   assignment["app_role_id"].(string),
+		})
+	}
+
+	return result
+}
+
+// appRoleAssignmentsValidateAppRoles checks that each desired app role is declared by the resource service principal.
+// The default app role ID can only be assigned when the resource service principal does not declare any app roles.
+func appRoleAssignmentsValidateAppRoles(appRoles []stable.AppRole, desired []appRoleAssignmentPair) error {
+	for _, pair := range desired {
+		if pair.appRoleId == defaultAppRoleId {
+			if len(appRoles) > 0 {
+				return fmt.Errorf("the default app role ID %q cannot be assigned because the resource service principal declares app roles", defaultAppRoleId)
+			}
+			continue
+		}
+
+		found := false
+		for _, appRole := range appRoles {
+			if strings.EqualFold(pointer.From(appRole.Id), pair.appRoleId) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("app role with ID %q was not found", pair.appRoleId)
+		}
+	}
+
+	return nil
+}
+
+// appRoleAssignmentsReconcile creates any desired assignments that are missing, and removes any existing assignments
+// that are not desired, comparing both the principal and the app role of each assignment
+func appRoleAssignmentsReconcile(ctx context.Context, c *client.Client, id parse.AppRoleAssignmentsId, desired []appRoleAssignmentPair) error {
+	appRoleAssignments, err := appRoleAssignmentsList(ctx, c, id)
+	if err != nil {
+		return err
+	}
+
+	desiredKeys := make(map[appRoleAssignmentPair]bool)
+	for _, pair := range desired {
+		desiredKeys[pair.key()] = true
+	}
+
+	existing := make(map[appRoleAssignmentPair]bool)
+	for _, appRoleAssignment := range appRoleAssignments {
+		key := flattenAppRoleAssignmentPair(appRoleAssignment).key()
+		existing[key] = true
+
+		if !desiredKeys[key] {
+			if err = appRoleAssignmentsRemove(ctx, c, id, appRoleAssignment); err != nil {
+				return err
+			}
+		}
+	}
+
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+
+	options := approleassignedto.CreateAppRoleAssignedToOperationOptions{
+		RetryFunc: func(resp *http.Response, o *odata.OData) (bool, error) {
+			if response.WasNotFound(resp) {
+				return true, nil
+			} else if response.WasBadRequest(resp) && o != nil && o.Error != nil {
+				return o.Error.Match("Not a valid reference update"), nil
+			}
+			return false, nil
+		},
+	}
+
+	for _, pair := range desired {
+		if existing[pair.key()] {
+			continue
+		}
+		existing[pair.key()] = true
+
+		properties := stable.AppRoleAssignment{
+			AppRoleId:   pointer.To(pair.appRoleId),
+			PrincipalId: nullable.Value(pair.principalId),
+			ResourceId:  nullable.Value(id.ServicePrincipalId),
+		}
+
+		if _, err = c.AppRoleAssignedToClient.CreateAppRoleAssignedTo(ctx, servicePrincipalId, properties, options); err != nil {
+			return fmt.Errorf("assigning app role %q to principal %q: %v", pair.appRoleId, pair.principalId, err)
+		}
+	}
+
+	return nil
+}
+
+func appRoleAssignmentsRemove(ctx context.Context, c *client.Client, id parse.AppRoleAssignmentsId, appRoleAssignment stable.AppRoleAssignment) error {
+	assignmentId := stable.NewServicePrincipalIdAppRoleAssignedToID(id.ServicePrincipalId, pointer.From(appRoleAssignment.Id))
+
+	if resp, err := c.AppRoleAssignedToClient.DeleteAppRoleAssignedTo(ctx, assignmentId, approleassignedto.DefaultDeleteAppRoleAssignedToOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+		return fmt.Errorf("removing %s for principal %q: %v", assignmentId, appRoleAssignment.PrincipalId.GetOrZero(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/approleassignments/parse"
+)
+
+type AppRoleAssignmentsResource struct{}
+
+func TestAccAppRoleAssignments_appRole(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_role_assignments", "test")
+	r := AppRoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.appRole(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("principal_object_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.appRole(data, 3),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("principal_object_ids.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.appRole(data, 0),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("principal_object_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppRoleAssignments_allAppRoles(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_role_assignments", "test")
+	r := AppRoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.allAppRoles(data, "Query.All"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// Changing the app role assigned to a principal should replace its assignment
+			Config: r.allAppRoles(data, "Admin.All"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AppRoleAssignmentsResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.AppRoleAssignments.ServicePrincipalClient
+
+	id, err := parse.ParseAppRoleAssignmentsID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing App Role Assignments ID: %v", err)
+	}
+
+	resp, err := client.GetServicePrincipal(ctx, stable.NewServicePrincipalID(id.ServicePrincipalId), serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve service principal for %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (AppRoleAssignmentsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "test" {
+  count            = 3
+  display_name     = "acctest-appRoleAssignments-${count.index}-%[2]d"
+  security_enabled = true
+}
+`, AppRoleAssignmentResource{}.tenantAppTemplate(data), data.RandomInteger)
+}
+
+func (r AppRoleAssignmentsResource) appRole(data acceptance.TestData, count int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_app_role_assignments" "test" {
+  app_role_id          = azuread_service_principal.internal.app_role_ids["Admin.All"]
+  resource_object_id   = azuread_service_principal.internal.object_id
+  principal_object_ids = slice(azuread_group.test[*].object_id, 0, %[2]d)
+}
+`, r.template(data), count)
+}
+
+func (r AppRoleAssignmentsResource) allAppRoles(data acceptance.TestData, secondAppRole string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_app_role_assignments" "test" {
+  resource_object_id = azuread_service_principal.internal.object_id
+
+  assignment {
+    app_role_id         = azuread_service_principal.internal.app_role_ids["Admin.All"]
+    principal_object_id = azuread_group.test[0].object_id
+  }
+
+  assignment {
+    app_role_id         = azuread_service_principal.internal.app_role_ids["%[2]s"]
+    principal_object_id = azuread_group.test[1].object_id
+  }
+}
+`, r.template(data), secondAppRole)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignedto"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/applications"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/approleassignments/client"
+)
+
+// appRoleAssignmentPrincipalId determines the type of the directory object with the given ID, and returns an ID
+// suitable for listing the app role assignments granted to it
+func appRoleAssignmentPrincipalId(ctx context.Context, c *directoryobject.DirectoryObjectClient, objectId string) (resourceids.ResourceId, error) {
+	id := stable.NewDirectoryObjectID(objectId)
+
+	resp, err := c.GetDirectoryObject(ctx, id, directoryobject.DefaultGetDirectoryObjectOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", id, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", id)
+	}
+
+	switch odataType := strings.TrimPrefix(pointer.From(resp.Model.DirectoryObject().ODataType), "#microsoft.graph."); odataType {
+	case "group":
+		return pointer.To(stable.NewGroupID(objectId)), nil
+	case "servicePrincipal":
+		return pointer.To(stable.NewServicePrincipalID(objectId)), nil
+	case "user":
+		return pointer.To(stable.NewUserID(objectId)), nil
+	default:
+		return nil, fmt.Errorf("%s has unsupported object type %q, expected a user, group or service principal", id, odataType)
+	}
+}
+
+// appRoleAssignmentResourceAppRoles retrieves the app roles exposed by resource service principals, caching them so
+// that each service principal is only retrieved once
+type appRoleAssignmentResourceAppRoles struct {
+	client *serviceprincipal.ServicePrincipalClient
+	cache  map[string]map[string]string
+}
+
+func newAppRoleAssignmentResourceAppRoles(c *serviceprincipal.ServicePrincipalClient) *appRoleAssignmentResourceAppRoles {
+	return &appRoleAssignmentResourceAppRoles{
+		client: c,
+		cache:  make(map[string]map[string]string),
+	}
+}
+
+// AppRoleIDs returns a map of app role values to app role IDs for the resource service principal with the given ID
+func (r *appRoleAssignmentResourceAppRoles) AppRoleIDs(ctx context.Context, resourceId string) (map[string]string, error) {
+	if appRoleIds, ok := r.cache[resourceId]; ok {
+		return appRoleIds, nil
+	}
+
+	id := stable.NewServicePrincipalID(resourceId)
+
+	options := serviceprincipal.GetServicePrincipalOperationOptions{
+		Select: pointer.To([]string{"appRoles", "id"}),
+	}
+
+	resp, err := r.client.GetServicePrincipal(ctx, id, options)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", id, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", id)
+	}
+
+	appRoleIds := applications.FlattenAppRoleIDs(resp.Model.AppRoles)
+	r.cache[resourceId] = appRoleIds
+
+	return appRoleIds, nil
+}
+
+// AppRoleValue returns the value of the app role with the given ID, exposed by the resource service principal with the
+// given ID. An empty string is returned for the default app role, or when the app role does not have a value.
+func (r *appRoleAssignmentResourceAppRoles) AppRoleValue(ctx context.Context, resourceId, appRoleId string) (string, error) {
+	appRoleIds, err := r.AppRoleIDs(ctx, resourceId)
+	if err != nil {
+		return "", err
+	}
+
+	for value, id := range appRoleIds {
+		if strings.EqualFold(id, appRoleId) {
+			return value, nil
+		}
+	}
+
+	return "", nil
+}
+
+// listAppRoleAssignments returns the app role assignments for a resource service principal when resourceId is
+// specified, or the app role assignments granted to a principal when principalId is specified
+func listAppRoleAssignments(ctx context.Context, c *client.Client, resourceId, principalId string) ([]stable.AppRoleAssignment, error) {
+	if resourceId != "" {
+		id := stable.NewServicePrincipalID(resourceId)
+		resp, err := c.AppRoleAssignedToClient.ListAppRoleAssignedTos(ctx, id, approleassignedto.DefaultListAppRoleAssignedTosOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing app role assignments for %s: %v", id, err)
+		}
+		return pointer.From(resp.Model), nil
+	}
+
+	if principalId == "" {
+		return nil, errors.New("one of resourceId or principalId must be specified")
+	}
+
+	id, err := appRoleAssignmentPrincipalId(ctx, c.DirectoryObjectClient, principalId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.AppRoleAssignmentClient.ListAppRoleAssignments(ctx, id, client.ListAppRoleAssignmentsOperationOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing app role assignments for %s: %v", id, err)
+	}
+
+	return pointer.From(resp.Model), nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/graphrequest"
+)
+
+// AppRoleAssignmentClient lists the app role assignments granted to a user, group or service principal, which are
+// not yet covered by the SDK for all principal types
+type AppRoleAssignmentClient struct {
+	Client *msgraph.Client
+}
+
+func NewAppRoleAssignmentClientWithBaseURI(api environments.Api) (*AppRoleAssignmentClient, error) {
+	c, err := msgraph.NewClient(api, "approleassignment", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating AppRoleAssignmentClient: %+v", err)
+	}
+
+	return &AppRoleAssignmentClient{
+		Client: c,
+	}, nil
+}
+
+type ListAppRoleAssignmentsOperationOptions struct {
+	RetryFunc client.RequestRetryFunc
+}
+
+type ListAppRoleAssignmentsOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.AppRoleAssignment
+}
+
+// ListAppRoleAssignments - List the app role assignments granted to a principal. The principal ID should be a
+// stable.UserId, stable.GroupId or stable.ServicePrincipalId.
+func (c AppRoleAssignmentClient) ListAppRoleAssignments(ctx context.Context, id resourceids.ResourceId, options ListAppRoleAssignmentsOperationOptions) (result ListAppRoleAssignmentsOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodGet, fmt.Sprintf("%s/appRoleAssignments", id.ID()), nil, true, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValues[stable.AppRoleAssignment](resp)
+
+	return
+}
@@ -5,6 +5,7 @@
 package client
 
 import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignedto"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
 	"github.com/valiparsa/terraform-provider-azuread/internal/common"
@@ -12,6 +13,8 @@ import (
 
 type Client struct {
 	AppRoleAssignedToClient *approleassignedto.AppRoleAssignedToClient
+	AppRoleAssignmentClient *AppRoleAssignmentClient
+	DirectoryObjectClient   *directoryobject.DirectoryObjectClient
 	ServicePrincipalClient  *serviceprincipal.ServicePrincipalClient
 }
 
@@ -22,6 +25,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(appRoleAssignedToClient.Client)
 
+	appRoleAssignmentClient, err := NewAppRoleAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(appRoleAssignmentClient.Client)
+
+	directoryObjectClient, err := directoryobject.NewDirectoryObjectClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(directoryObjectClient.Client)
+
 	servicePrincipalClient, err := serviceprincipal.NewServicePrincipalClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -30,6 +45,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 
 	return &Client{
 		AppRoleAssignedToClient: appRoleAssignedToClient,
+		AppRoleAssignmentClient: appRoleAssignmentClient,
+		DirectoryObjectClient:   directoryObjectClient,
 		ServicePrincipalClient:  servicePrincipalClient,
 	}, nil
 }
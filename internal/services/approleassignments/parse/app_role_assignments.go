@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// AppRoleAssignmentsId identifies the app role assignments for a resource service principal, optionally for a single
+// app role. When AppRoleId is empty, the ID refers to the assignments for all app roles.
+type AppRoleAssignmentsId struct {
+	ServicePrincipalId string
+	AppRoleId          string
+}
+
+func NewAppRoleAssignmentsID(servicePrincipalId, appRoleId string) AppRoleAssignmentsId {
+	return AppRoleAssignmentsId{
+		ServicePrincipalId: servicePrincipalId,
+		AppRoleId:          appRoleId,
+	}
+}
+
+func (id AppRoleAssignmentsId) ID() string {
+	if id.AppRoleId == "" {
+		return fmt.Sprintf("/servicePrincipals/%s/appRoleAssignedTo", id.ServicePrincipalId)
+	}
+	return fmt.Sprintf("/servicePrincipals/%s/appRoleAssignedTo/appRoles/%s", id.ServicePrincipalId, id.AppRoleId)
+}
+
+func (id AppRoleAssignmentsId) String() string {
+	if id.AppRoleId == "" {
+		return fmt.Sprintf("App Role Assignments (Service Principal ID: %q)", id.ServicePrincipalId)
+	}
+	return fmt.Sprintf("App Role Assignments (Service Principal ID: %q, App Role ID: %q)", id.ServicePrincipalId, id.AppRoleId)
+}
+
+// ParseAppRoleAssignmentsID parses 'input' into an AppRoleAssignmentsId
+func ParseAppRoleAssignmentsID(input string) (*AppRoleAssignmentsId, error) {
+	parts := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if (len(parts) != 3 && len(parts) != 5) || parts[0] != "servicePrincipals" || parts[2] != "appRoleAssignedTo" || (len(parts) == 5 && parts[3] != "appRoles") {
+		return nil, fmt.Errorf("App Role Assignments ID should be in the format /servicePrincipals/{servicePrincipalId}/appRoleAssignedTo or /servicePrincipals/{servicePrincipalId}/appRoleAssignedTo/appRoles/{appRoleId} - but got %q", input)
+	}
+
+	id := AppRoleAssignmentsId{
+		ServicePrincipalId: parts[1],
+	}
+	if len(parts) == 5 {
+		id.AppRoleId = parts[4]
+	}
+
+	if _, err := uuid.ParseUUID(id.ServicePrincipalId); err != nil {
+		return nil, fmt.Errorf("Service Principal ID isn't a valid UUID (%q): %+v", id.ServicePrincipalId, err)
+	}
+
+	if id.AppRoleId != "" {
+		if _, err := uuid.ParseUUID(id.AppRoleId); err != nil {
+			return nil, fmt.Errorf("App Role ID isn't a valid UUID (%q): %+v", id.AppRoleId, err)
+		}
+	}
+
+	return &id, nil
+}
+
+// ValidateAppRoleAssignmentsID checks that 'input' can be parsed as an App Role Assignments ID
+func ValidateAppRoleAssignmentsID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAppRoleAssignmentsID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
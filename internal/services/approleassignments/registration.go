@@ -27,12 +27,15 @@ func (r Registration) WebsiteCategories() []string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azuread_app_role_assignments": appRoleAssignmentsDataSource(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_app_role_assignment": appRoleAssignmentResource(),
+		"azuread_app_role_assignment":  appRoleAssignmentResource(),
+		"azuread_app_role_assignments": appRoleAssignmentsResource(),
 	}
 }
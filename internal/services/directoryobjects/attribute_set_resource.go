@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directoryobjects
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	directoryObjectsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/directoryobjects/client"
+)
+
+func attributeSetResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: attributeSetResourceCreate,
+		ReadContext:   attributeSetResourceRead,
+		UpdateContext: attributeSetResourceUpdate,
+		DeleteContext: attributeSetResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := stable.ParseDirectoryAttributeSetID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Description:  "The name of the attribute set, which must be unique in the tenant",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_]{1,32}$`), "must be up to 32 characters long and cannot contain spaces or special characters"),
+			},
+
+			"description": {
+				Description:  "The description of the attribute set",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+
+			"max_attributes_per_set": {
+				Description:  "The maximum number of custom security attributes that can be defined in the attribute set",
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+			},
+		},
+	}
+}
+
+func attributeSetResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.CustomSecurityAttributeClient
+
+	name := d.Get("name").(string)
+	id := stable.NewDirectoryAttributeSetID(name)
+
+	resp, err := client.GetAttributeSet(ctx, id, directoryObjectsClient.CustomSecurityAttributeOperationOptions{})
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Checking for existing %s", id)
+		}
+	} else {
+		return tf.ImportAsExistsDiag("azuread_attribute_set", id.ID())
+	}
+
+	properties := stable.AttributeSet{
+		Id:          pointer.To(name),
+		Description: nullable.NoZero(d.Get("description").(string)),
+	}
+
+	if v, ok := d.GetOk("max_attributes_per_set"); ok {
+		properties.MaxAttributesPerSet = nullable.Value(int64(v.(int)))
+	}
+
+	if _, err = client.CreateAttributeSet(ctx, properties, directoryObjectsClient.CustomSecurityAttributeOperationOptions{}); err != nil {
+		return tf.ErrorDiagF(err, "Creating attribute set %q", name)
+	}
+
+	d.SetId(id.ID())
+
+	// Wait for the attribute set to replicate
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetAttributeSet(ctx, id, directoryObjectsClient.CustomSecurityAttributeOperationOptions{})
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return attributeSetResourceRead(ctx, d, meta)
+}
+
+func attributeSetResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.CustomSecurityAttributeClient
+
+	id, err := stable.ParseDirectoryAttributeSetID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	properties := stable.AttributeSet{
+		Description:         nullable.NoZero(d.Get("description").(string)),
+		MaxAttributesPerSet: nullable.NoZero(int64(d.Get("max_attributes_per_set").(int))),
+	}
+
+	if _, err = client.UpdateAttributeSet(ctx, *id, properties, directoryObjectsClient.CustomSecurityAttributeOperationOptions{}); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return attributeSetResourceRead(ctx, d, meta)
+}
+
+func attributeSetResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.CustomSecurityAttributeClient
+
+	id, err := stable.ParseDirectoryAttributeSetID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetAttributeSet(ctx, *id, directoryObjectsClient.CustomSecurityAttributeOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	attributeSet := resp.Model
+	if attributeSet == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "description", attributeSet.Description.GetOrZero())
+	tf.Set(d, "max_attributes_per_set", int(attributeSet.MaxAttributesPerSet.GetOrZero()))
+	tf.Set(d, "name", id.AttributeSetId)
+
+	return nil
+}
+
+func attributeSetResourceDelete(_ context.Context, d *pluginsdk.ResourceData, _ interface{}) pluginsdk.Diagnostics {
+	// Attribute sets cannot be deleted, so they are only removed from state
+	log.Printf("[DEBUG] Attribute sets cannot be deleted, %s will remain in the tenant", d.Id())
+	return nil
+}
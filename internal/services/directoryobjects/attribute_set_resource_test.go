@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directoryobjects_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	directoryObjectsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/directoryobjects/client"
+)
+
+type AttributeSetResource struct{}
+
+// Attribute sets cannot be deleted, so these tests leave an attribute set behind in the tenant
+
+func TestAccAttributeSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_attribute_set", "test")
+	r := AttributeSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAttributeSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_attribute_set", "test")
+	r := AttributeSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("Attributes for acceptance tests"),
+				check.That(data.ResourceName).Key("max_attributes_per_set").HasValue("25"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AttributeSetResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.DirectoryObjects.CustomSecurityAttributeClient
+
+	id, err := stable.ParseDirectoryAttributeSetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetAttributeSet(ctx, *id, directoryObjectsClient.CustomSecurityAttributeOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (AttributeSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_attribute_set" "test" {
+  name = "acctest%[1]s"
+}
+`, data.RandomString)
+}
+
+func (AttributeSetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_attribute_set" "test" {
+  name                   = "acctest%[1]s"
+  description            = "Attributes for acceptance tests"
+  max_attributes_per_set = 25
+}
+`, data.RandomString)
+}
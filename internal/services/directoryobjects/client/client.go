@@ -10,10 +10,17 @@ import (
 )
 
 type Client struct {
-	DirectoryObjectClient *directoryobject.DirectoryObjectClient
+	CustomSecurityAttributeClient *CustomSecurityAttributeClient
+	DirectoryObjectClient         *directoryobject.DirectoryObjectClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	customSecurityAttributeClient, err := NewCustomSecurityAttributeClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(customSecurityAttributeClient.Client)
+
 	directoryObjectClient, err := directoryobject.NewDirectoryObjectClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -21,6 +28,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(directoryObjectClient.Client)
 
 	return &Client{
-		DirectoryObjectClient: directoryObjectClient,
+		CustomSecurityAttributeClient: customSecurityAttributeClient,
+		DirectoryObjectClient:         directoryObjectClient,
 	}, nil
 }
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/graphrequest"
+)
+
+// CustomSecurityAttributeClient manages attribute sets, and the custom security attribute definitions within them,
+// which are not yet covered by the SDK. Neither can be deleted once created.
+type CustomSecurityAttributeClient struct {
+	Client *msgraph.Client
+}
+
+func NewCustomSecurityAttributeClientWithBaseURI(api environments.Api) (*CustomSecurityAttributeClient, error) {
+	c, err := msgraph.NewClient(api, "customsecurityattribute", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating CustomSecurityAttributeClient: %+v", err)
+	}
+
+	return &CustomSecurityAttributeClient{
+		Client: c,
+	}, nil
+}
+
+type CustomSecurityAttributeOperationOptions struct {
+	RetryFunc client.RequestRetryFunc
+}
+
+type AttributeSetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.AttributeSet
+}
+
+type CustomSecurityAttributeDefinitionOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.CustomSecurityAttributeDefinition
+}
+
+type ListAllowedValuesOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.AllowedValue
+}
+
+type AllowedValueOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.AllowedValue
+}
+
+type UpdateCustomSecurityAttributeOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+// CreateAttributeSet - Create an attribute set
+func (c CustomSecurityAttributeClient) CreateAttributeSet(ctx context.Context, input stable.AttributeSet, options CustomSecurityAttributeOperationOptions) (result AttributeSetOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPost, "/directory/attributeSets", input, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.AttributeSet
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
+
+// GetAttributeSet - Retrieve an attribute set
+func (c CustomSecurityAttributeClient) GetAttributeSet(ctx context.Context, id stable.DirectoryAttributeSetId, options CustomSecurityAttributeOperationOptions) (result AttributeSetOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, id.ID(), nil, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.AttributeSet
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
+
+// UpdateAttributeSet - Update the description or maximum number of attributes of an attribute set
+func (c CustomSecurityAttributeClient) UpdateAttributeSet(ctx context.Context, id stable.DirectoryAttributeSetId, input stable.AttributeSet, options CustomSecurityAttributeOperationOptions) (result UpdateCustomSecurityAttributeOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPatch, id.ID(), input, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+// CreateCustomSecurityAttributeDefinition - Create a custom security attribute definition, including any predefined
+// values
+func (c CustomSecurityAttributeClient) CreateCustomSecurityAttributeDefinition(ctx context.Context, input stable.CustomSecurityAttributeDefinition, options CustomSecurityAttributeOperationOptions) (result CustomSecurityAttributeDefinitionOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPost, "/directory/customSecurityAttributeDefinitions", input, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.CustomSecurityAttributeDefinition
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
+
+// GetCustomSecurityAttributeDefinition - Retrieve a custom security attribute definition
+func (c CustomSecurityAttributeClient) GetCustomSecurityAttributeDefinition(ctx context.Context, id stable.DirectoryCustomSecurityAttributeDefinitionId, options CustomSecurityAttributeOperationOptions) (result CustomSecurityAttributeDefinitionOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, id.ID(), nil, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.CustomSecurityAttributeDefinition
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
+
+// UpdateCustomSecurityAttributeDefinition - Update the description, status or predefined value enforcement of a
+// custom security attribute definition
+func (c CustomSecurityAttributeClient) UpdateCustomSecurityAttributeDefinition(ctx context.Context, id stable.DirectoryCustomSecurityAttributeDefinitionId, input stable.CustomSecurityAttributeDefinition, options CustomSecurityAttributeOperationOptions) (result UpdateCustomSecurityAttributeOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPatch, id.ID(), input, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+// ListAllowedValues - List the predefined values of a custom security attribute definition
+func (c CustomSecurityAttributeClient) ListAllowedValues(ctx context.Context, id stable.DirectoryCustomSecurityAttributeDefinitionId, options CustomSecurityAttributeOperationOptions) (result ListAllowedValuesOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, fmt.Sprintf("%s/allowedValues", id.ID()), nil, true, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValues[stable.AllowedValue](resp)
+
+	return
+}
+
+// CreateAllowedValue - Add a predefined value to a custom security attribute definition
+func (c CustomSecurityAttributeClient) CreateAllowedValue(ctx context.Context, id stable.DirectoryCustomSecurityAttributeDefinitionId, input stable.AllowedValue, options CustomSecurityAttributeOperationOptions) (result AllowedValueOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPost, fmt.Sprintf("%s/allowedValues", id.ID()), input, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.AllowedValue
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
+
+// UpdateAllowedValue - Activate or deactivate a predefined value of a custom security attribute definition
+func (c CustomSecurityAttributeClient) UpdateAllowedValue(ctx context.Context, id stable.DirectoryCustomSecurityAttributeDefinitionIdAllowedValueId, input stable.AllowedValue, options CustomSecurityAttributeOperationOptions) (result UpdateCustomSecurityAttributeOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPatch, id.ID(), input, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+func (c CustomSecurityAttributeClient) execute(ctx context.Context, method, path string, input interface{}, paged bool, options CustomSecurityAttributeOperationOptions) (*client.Response, error) {
+	return graphrequest.Execute(ctx, c.Client, method, path, input, paged, graphrequest.Options{RetryFunc: options.RetryFunc})
+}
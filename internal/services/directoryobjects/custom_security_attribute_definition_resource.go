@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directoryobjects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/customsecurityattributes"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	directoryObjectsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/directoryobjects/client"
+)
+
+const (
+	customSecurityAttributeStatusAvailable  = "Available"
+	customSecurityAttributeStatusDeprecated = "Deprecated"
+)
+
+func customSecurityAttributeDefinitionResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: customSecurityAttributeDefinitionResourceCreate,
+		ReadContext:   customSecurityAttributeDefinitionResourceRead,
+		UpdateContext: customSecurityAttributeDefinitionResourceUpdate,
+		DeleteContext: customSecurityAttributeDefinitionResourceDelete,
+
+		CustomizeDiff: customSecurityAttributeDefinitionResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := stable.ParseDirectoryCustomSecurityAttributeDefinitionID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"attribute_set": {
+				Description:  "The name of the attribute set in which to define the custom security attribute",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"name": {
+				Description:  "The name of the custom security attribute, which must be unique within the attribute set",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_]{1,32}$`), "must be up to 32 characters long and cannot contain spaces or special characters"),
+			},
+
+			"type": {
+				Description:  "The data type of the custom security attribute. Must be one of `Boolean`, `Integer` or `String`",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{customsecurityattributes.TypeBoolean, customsecurityattributes.TypeInteger, customsecurityattributes.TypeString}, false),
+			},
+
+			"collection": {
+				Description: "Whether multiple values can be assigned to the custom security attribute",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+
+			"searchable": {
+				Description: "Whether custom security attribute values are indexed for searching on objects that are assigned attribute values",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+
+			"description": {
+				Description:  "The description of the custom security attribute",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+
+			"predefined_values_only": {
+				Description: "Whether only predefined values can be assigned to the custom security attribute",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"allowed_values": {
+				Description: "The predefined values that can be assigned to the custom security attribute",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+
+			"status": {
+				Description:  "The status of the custom security attribute. Must be one of `Available` or `Deprecated`",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      customSecurityAttributeStatusAvailable,
+				ValidateFunc: validation.StringInSlice([]string{customSecurityAttributeStatusAvailable, customSecurityAttributeStatusDeprecated}, false),
+			},
+		},
+	}
+}
+
+func customSecurityAttributeDefinitionResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	attrType := diff.Get("type").(string)
+
+	if attrType == customsecurityattributes.TypeBoolean {
+		if diff.Get("collection").(bool) {
+			return fmt.Errorf("`collection` cannot be true when `type` is %q", attrType)
+		}
+		if diff.Get("predefined_values_only").(bool) {
+			return fmt.Errorf("`predefined_values_only` cannot be true when `type` is %q", attrType)
+		}
+	}
+
+	if attrType != customsecurityattributes.TypeString && diff.Get("allowed_values").(*pluginsdk.Set).Len() > 0 {
+		return fmt.Errorf("`allowed_values` can only be specified when `type` is %q", customsecurityattributes.TypeString)
+	}
+
+	return nil
+}
+
+func customSecurityAttributeDefinitionResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.CustomSecurityAttributeClient
+
+	attributeSet := d.Get("attribute_set").(string)
+	name := d.Get("name").(string)
+
+	// Definition IDs are composed of the attribute set and the attribute name
+	id := stable.NewDirectoryCustomSecurityAttributeDefinitionID(fmt.Sprintf("%s_%s", attributeSet, name))
+
+	resp, err := client.GetCustomSecurityAttributeDefinition(ctx, id, directoryObjectsClient.CustomSecurityAttributeOperationOptions{})
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Checking for existing %s", id)
+		}
+	} else {
+		return tf.ImportAsExistsDiag("azuread_custom_security_attribute_definition", id.ID())
+	}
+
+	allowedValues := make([]stable.AllowedValue, 0)
+	for _, v := range d.Get("allowed_values").(*pluginsdk.Set).List() {
+		allowedValues = append(allowedValues, stable.AllowedValue{
+			Id:       pointer.To(v.(string)),
+			IsActive: nullable.Value(true),
+		})
+	}
+
+	properties := stable.CustomSecurityAttributeDefinition{
+		AllowedValues:           &allowedValues,
+		AttributeSet:            pointer.To(attributeSet),
+		Description:             nullable.NoZero(d.Get("description").(string)),
+		IsCollection:            pointer.To(d.Get("collection").(bool)),
+		IsSearchable:            nullable.Value(d.Get("searchable").(bool)),
+		Name:                    pointer.To(name),
+		Status:                  pointer.To(d.Get("status").(string)),
+		Type:                    pointer.To(d.Get("type").(string)),
+		UsePreDefinedValuesOnly: nullable.Value(d.Get("predefined_values_only").(bool)),
+	}
+
+	createResp, err := client.CreateCustomSecurityAttributeDefinition(ctx, properties, directoryObjectsClient.CustomSecurityAttributeOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(createResp.HttpResponse) {
+			return tf.ErrorDiagPathF(err, "attribute_set", "Attribute set %q was not found", attributeSet)
+		}
+		return tf.ErrorDiagF(err, "Creating custom security attribute definition %q in attribute set %q", name, attributeSet)
+	}
+
+	if createResp.Model != nil && createResp.Model.Id != nil && *createResp.Model.Id != "" {
+		id = stable.NewDirectoryCustomSecurityAttributeDefinitionID(*createResp.Model.Id)
+	}
+
+	d.SetId(id.ID())
+
+	// Wait for the definition to replicate
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetCustomSecurityAttributeDefinition(ctx, id, directoryObjectsClient.CustomSecurityAttributeOperationOptions{})
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return customSecurityAttributeDefinitionResourceRead(ctx, d, meta)
+}
+
+func customSecurityAttributeDefinitionResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.CustomSecurityAttributeClient
+
+	id, err := stable.ParseDirectoryCustomSecurityAttributeDefinitionID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	// Predefined values cannot be deleted, so values removed from configuration are deactivated instead
+	if d.HasChange("allowed_values") {
+		resp, err := client.ListAllowedValues(ctx, *id, directoryObjectsClient.CustomSecurityAttributeOperationOptions{})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving predefined values for %s", id)
+		}
+
+		existing := make(map[string]bool)
+		for _, v := range pointer.From(resp.Model) {
+			existing[pointer.From(v.Id)] = v.IsActive.GetOrZero()
+		}
+
+		desired := make(map[string]bool)
+		for _, v := range d.Get("allowed_values").(*pluginsdk.Set).List() {
+			desired[v.(string)] = true
+		}
+
+		for value := range desired {
+			active, ok := existing[value]
+			if !ok {
+				allowedValue := stable.AllowedValue{
+					Id:       pointer.To(value),
+					IsActive: nullable.Value(true),
+				}
+				if _, err = client.CreateAllowedValue(ctx, *id, allowedValue, directoryObjectsClient.CustomSecurityAttributeOperationOptions{}); err != nil {
+					return tf.ErrorDiagPathF(err, "allowed_values", "Adding predefined value %q for %s", value, id)
+				}
+			} else if !active {
+				allowedValueId := stable.NewDirectoryCustomSecurityAttributeDefinitionIdAllowedValueID(id.CustomSecurityAttributeDefinitionId, value)
+				if _, err = client.UpdateAllowedValue(ctx, allowedValueId, stable.AllowedValue{IsActive: nullable.Value(true)}, directoryObjectsClient.CustomSecurityAttributeOperationOptions{}); err != nil {
+					return tf.ErrorDiagPathF(err, "allowed_values", "Reactivating %s", allowedValueId)
+				}
+			}
+		}
+
+		for value, active := range existing {
+			if active && !desired[value] {
+				allowedValueId := stable.NewDirectoryCustomSecurityAttributeDefinitionIdAllowedValueID(id.CustomSecurityAttributeDefinitionId, value)
+				if _, err = client.UpdateAllowedValue(ctx, allowedValueId, stable.AllowedValue{IsActive: nullable.Value(false)}, directoryObjectsClient.CustomSecurityAttributeOperationOptions{}); err != nil {
+					return tf.ErrorDiagPathF(err, "allowed_values", "Deactivating %s", allowedValueId)
+				}
+			}
+		}
+	}
+
+	properties := stable.CustomSecurityAttributeDefinition{
+		Description:             nullable.NoZero(d.Get("description").(string)),
+		Status:                  pointer.To(d.Get("status").(string)),
+		UsePreDefinedValuesOnly: nullable.Value(d.Get("predefined_values_only").(bool)),
+	}
+
+	if _, err = client.UpdateCustomSecurityAttributeDefinition(ctx, *id, properties, directoryObjectsClient.CustomSecurityAttributeOperationOptions{}); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return customSecurityAttributeDefinitionResourceRead(ctx, d, meta)
+}
+
+func customSecurityAttributeDefinitionResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.CustomSecurityAttributeClient
+
+	id, err := stable.ParseDirectoryCustomSecurityAttributeDefinitionID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetCustomSecurityAttributeDefinition(ctx, *id, directoryObjectsClient.CustomSecurityAttributeOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	definition := resp.Model
+	if definition == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	allowedValuesResp, err := client.ListAllowedValues(ctx, *id, directoryObjectsClient.CustomSecurityAttributeOperationOptions{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving predefined values for %s", id)
+	}
+
+	allowedValues := make([]string, 0)
+	for _, v := range pointer.From(allowedValuesResp.Model) {
+		if v.IsActive.GetOrZero() {
+			allowedValues = append(allowedValues, pointer.From(v.Id))
+		}
+	}
+
+	tf.Set(d, "allowed_values", allowedValues)
+	tf.Set(d, "attribute_set", pointer.From(definition.AttributeSet))
+	tf.Set(d, "collection", pointer.From(definition.IsCollection))
+	tf.Set(d, "description", definition.Description.GetOrZero())
+	tf.Set(d, "name", pointer.From(definition.Name))
+	tf.Set(d, "predefined_values_only", definition.UsePreDefinedValuesOnly.GetOrZero())
+	tf.Set(d, "searchable", definition.IsSearchable.GetOrZero())
+	tf.Set(d, "status", pointer.From(definition.Status))
+	tf.Set(d, "type", pointer.From(definition.Type))
+
+	return nil
+}
+
+func customSecurityAttributeDefinitionResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.CustomSecurityAttributeClient
+
+	id, err := stable.ParseDirectoryCustomSecurityAttributeDefinitionID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	// Custom security attribute definitions cannot be deleted, so they are deprecated instead
+	properties := stable.CustomSecurityAttributeDefinition{
+		Status: pointer.To(customSecurityAttributeStatusDeprecated),
+	}
+
+	if resp, err := client.UpdateCustomSecurityAttributeDefinition(ctx, *id, properties, directoryObjectsClient.CustomSecurityAttributeOperationOptions{}); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deprecating %s", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directoryobjects_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	directoryObjectsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/directoryobjects/client"
+)
+
+type CustomSecurityAttributeDefinitionResource struct{}
+
+// Custom security attribute definitions cannot be deleted, so these tests leave deprecated definitions behind in the tenant
+
+func TestAccCustomSecurityAttributeDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_security_attribute_definition", "test")
+	r := CustomSecurityAttributeDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Available"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCustomSecurityAttributeDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_security_attribute_definition", "test")
+	r := CustomSecurityAttributeDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, `"Alpine", "Baker"`, "Available"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allowed_values.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, `"Baker", "Cascade"`, "Deprecated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allowed_values.#").HasValue("2"),
+				check.That(data.ResourceName).Key("status").HasValue("Deprecated"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCustomSecurityAttributeDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_security_attribute_definition", "test")
+	r := CustomSecurityAttributeDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func TestAccCustomSecurityAttributeDefinition_invalidBooleanCollection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_security_attribute_definition", "test")
+	r := CustomSecurityAttributeDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidBooleanCollection(data),
+			ExpectError: regexp.MustCompile("`collection` cannot be true when `type` is \"Boolean\""),
+		},
+	})
+}
+
+func (r CustomSecurityAttributeDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.DirectoryObjects.CustomSecurityAttributeClient
+
+	id, err := stable.ParseDirectoryCustomSecurityAttributeDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetCustomSecurityAttributeDefinition(ctx, *id, directoryObjectsClient.CustomSecurityAttributeOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (CustomSecurityAttributeDefinitionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_attribute_set" "test" {
+  name = "acctest%[1]s"
+}
+`, data.RandomString)
+}
+
+func (r CustomSecurityAttributeDefinitionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_custom_security_attribute_definition" "test" {
+  attribute_set = azuread_attribute_set.test.name
+  name          = "Level"
+  type          = "Integer"
+}
+`, r.template(data))
+}
+
+func (r CustomSecurityAttributeDefinitionResource) complete(data acceptance.TestData, allowedValues, status string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_custom_security_attribute_definition" "test" {
+  attribute_set          = azuread_attribute_set.test.name
+  name                   = "Project"
+  description            = "Active projects"
+  type                   = "String"
+  collection             = true
+  searchable             = true
+  predefined_values_only = true
+  allowed_values         = [%[2]s]
+  status                 = "%[3]s"
+}
+`, r.template(data), allowedValues, status)
+}
+
+func (r CustomSecurityAttributeDefinitionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_custom_security_attribute_definition" "import" {
+  attribute_set = azuread_custom_security_attribute_definition.test.attribute_set
+  name          = azuread_custom_security_attribute_definition.test.name
+  type          = azuread_custom_security_attribute_definition.test.type
+}
+`, r.basic(data))
+}
+
+func (r CustomSecurityAttributeDefinitionResource) invalidBooleanCollection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_custom_security_attribute_definition" "test" {
+  attribute_set = azuread_attribute_set.test.name
+  name          = "Certified"
+  type          = "Boolean"
+  collection    = true
+}
+`, r.template(data))
+}
@@ -34,5 +34,8 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azuread_attribute_set":                        attributeSetResource(),
+		"azuread_custom_security_attribute_definition": customSecurityAttributeDefinitionResource(),
+	}
 }
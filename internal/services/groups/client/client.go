@@ -23,10 +23,13 @@ type Client struct {
 	AdministrativeUnitMemberClientBeta *administrativeunitmemberBeta.AdministrativeUnitMemberClient
 	DirectoryObjectClient              *directoryobject.DirectoryObjectClient
 	GroupClientBeta                    *groupBeta.GroupClient
+	GroupLifecyclePolicyClient         *GroupLifecyclePolicyClient
 	GroupMemberClientBeta              *memberBeta.MemberClient
 	GroupMemberOfClientBeta            *memberofBeta.MemberOfClient
 	GroupOwnerClientBeta               *ownerBeta.OwnerClient
+	GroupSettingClient                 *GroupSettingClient
 	GroupTransitiveMemberClientBeta    *transitivememberBeta.TransitiveMemberClient
+	PrincipalMemberOfClient            *PrincipalMemberOfClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -49,6 +52,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(groupClientBeta.Client)
 
+	groupLifecyclePolicyClient, err := NewGroupLifecyclePolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(groupLifecyclePolicyClient.Client)
+
 	// Group members not returned in full when using v1.0 API, see https://github.com/valiparsa/terraform-provider-azuread/issues/1018
 	memberClientBeta, err := memberBeta.NewMemberClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
@@ -68,6 +77,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(ownerClientBeta.Client)
 
+	groupSettingClient, err := NewGroupSettingClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(groupSettingClient.Client)
+
 	// Group members not returned in full when using v1.0 API, see https://github.com/valiparsa/terraform-provider-azuread/issues/1018
 	transitiveMemberClientBeta, err := transitivememberBeta.NewTransitiveMemberClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
@@ -75,13 +90,22 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(transitiveMemberClientBeta.Client)
 
+	principalMemberOfClient, err := NewPrincipalMemberOfClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(principalMemberOfClient.Client)
+
 	return &Client{
 		AdministrativeUnitMemberClientBeta: administrativeUnitMemberClientBeta,
 		DirectoryObjectClient:              directoryObjectClient,
 		GroupClientBeta:                    groupClientBeta,
+		GroupLifecyclePolicyClient:         groupLifecyclePolicyClient,
 		GroupMemberClientBeta:              memberClientBeta,
 		GroupMemberOfClientBeta:            memberOfClientBeta,
 		GroupOwnerClientBeta:               ownerClientBeta,
+		GroupSettingClient:                 groupSettingClient,
 		GroupTransitiveMemberClientBeta:    transitiveMemberClientBeta,
+		PrincipalMemberOfClient:            principalMemberOfClient,
 	}, nil
 }
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/graphrequest"
+)
+
+// GroupLifecyclePolicyClient manages group expiration policies and the groups they apply to, which are not yet
+// covered by the SDK
+type GroupLifecyclePolicyClient struct {
+	Client *msgraph.Client
+}
+
+func NewGroupLifecyclePolicyClientWithBaseURI(api environments.Api) (*GroupLifecyclePolicyClient, error) {
+	c, err := msgraph.NewClient(api, "grouplifecyclepolicy", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating GroupLifecyclePolicyClient: %+v", err)
+	}
+
+	return &GroupLifecyclePolicyClient{
+		Client: c,
+	}, nil
+}
+
+type GroupLifecyclePolicyOperationOptions struct {
+	RetryFunc client.RequestRetryFunc
+}
+
+type GroupLifecyclePolicyOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.GroupLifecyclePolicy
+}
+
+type ListGroupLifecyclePoliciesOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.GroupLifecyclePolicy
+}
+
+type GroupLifecyclePolicyGroupOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *bool
+}
+
+type GroupLifecyclePolicyGroupRequest struct {
+	GroupId string `json:"groupId"`
+}
+
+// ListGroupLifecyclePolicies - List the group lifecycle policies in the tenant
+func (c GroupLifecyclePolicyClient) ListGroupLifecyclePolicies(ctx context.Context, options GroupLifecyclePolicyOperationOptions) (ListGroupLifecyclePoliciesOperationResponse, error) {
+	return c.listGroupLifecyclePolicies(ctx, "/groupLifecyclePolicies", options)
+}
+
+// ListGroupLifecyclePoliciesForGroup - List the group lifecycle policies that apply to a group
+func (c GroupLifecyclePolicyClient) ListGroupLifecyclePoliciesForGroup(ctx context.Context, id stable.GroupId, options GroupLifecyclePolicyOperationOptions) (ListGroupLifecyclePoliciesOperationResponse, error) {
+	return c.listGroupLifecyclePolicies(ctx, fmt.Sprintf("%s/groupLifecyclePolicies", id.ID()), options)
+}
+
+func (c GroupLifecyclePolicyClient) listGroupLifecyclePolicies(ctx context.Context, path string, options GroupLifecyclePolicyOperationOptions) (result ListGroupLifecyclePoliciesOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodGet, path, nil, true, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValues[stable.GroupLifecyclePolicy](resp)
+
+	return
+}
+
+// CreateGroupLifecyclePolicy - Create a group lifecycle policy. Only one policy can exist in a tenant.
+func (c GroupLifecyclePolicyClient) CreateGroupLifecyclePolicy(ctx context.Context, input stable.GroupLifecyclePolicy, options GroupLifecyclePolicyOperationOptions) (GroupLifecyclePolicyOperationResponse, error) {
+	return c.execute(ctx, http.MethodPost, "/groupLifecyclePolicies", input, true, options)
+}
+
+// GetGroupLifecyclePolicy - Retrieve the properties of a group lifecycle policy
+func (c GroupLifecyclePolicyClient) GetGroupLifecyclePolicy(ctx context.Context, policyId string, options GroupLifecyclePolicyOperationOptions) (GroupLifecyclePolicyOperationResponse, error) {
+	return c.execute(ctx, http.MethodGet, fmt.Sprintf("/groupLifecyclePolicies/%s", policyId), nil, true, options)
+}
+
+// UpdateGroupLifecyclePolicy - Update the properties of a group lifecycle policy
+func (c GroupLifecyclePolicyClient) UpdateGroupLifecyclePolicy(ctx context.Context, policyId string, input stable.GroupLifecyclePolicy, options GroupLifecyclePolicyOperationOptions) (GroupLifecyclePolicyOperationResponse, error) {
+	return c.execute(ctx, http.MethodPatch, fmt.Sprintf("/groupLifecyclePolicies/%s", policyId), input, false, options)
+}
+
+// DeleteGroupLifecyclePolicy - Delete a group lifecycle policy
+func (c GroupLifecyclePolicyClient) DeleteGroupLifecyclePolicy(ctx context.Context, policyId string, options GroupLifecyclePolicyOperationOptions) (GroupLifecyclePolicyOperationResponse, error) {
+	return c.execute(ctx, http.MethodDelete, fmt.Sprintf("/groupLifecyclePolicies/%s", policyId), nil, false, options)
+}
+
+func (c GroupLifecyclePolicyClient) execute(ctx context.Context, method, path string, input interface{}, unmarshal bool, options GroupLifecyclePolicyOperationOptions) (result GroupLifecyclePolicyOperationResponse, err error) {
+	var resp *client.Response
+	resp, err = graphrequest.Execute(ctx, c.Client, method, path, input, false, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil || !unmarshal {
+		return
+	}
+
+	var model stable.GroupLifecyclePolicy
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
+
+// AddGroup - Add a group to a group lifecycle policy. This is only applicable when the policy applies to selected groups.
+func (c GroupLifecyclePolicyClient) AddGroup(ctx context.Context, policyId string, groupId string, options GroupLifecyclePolicyOperationOptions) (GroupLifecyclePolicyGroupOperationResponse, error) {
+	return c.groupAction(ctx, fmt.Sprintf("/groupLifecyclePolicies/%s/addGroup", policyId), groupId, options)
+}
+
+// RemoveGroup - Remove a group from a group lifecycle policy. This is only applicable when the policy applies to
+// selected groups.
+func (c GroupLifecyclePolicyClient) RemoveGroup(ctx context.Context, policyId string, groupId string, options GroupLifecyclePolicyOperationOptions) (GroupLifecyclePolicyGroupOperationResponse, error) {
+	return c.groupAction(ctx, fmt.Sprintf("/groupLifecyclePolicies/%s/removeGroup", policyId), groupId, options)
+}
+
+func (c GroupLifecyclePolicyClient) groupAction(ctx context.Context, path string, groupId string, options GroupLifecyclePolicyOperationOptions) (result GroupLifecyclePolicyGroupOperationResponse, err error) {
+	var resp *client.Response
+	resp, err = graphrequest.Execute(ctx, c.Client, http.MethodPost, path, GroupLifecyclePolicyGroupRequest{GroupId: groupId}, false, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var value struct {
+		Value *bool `json:"value"`
+	}
+	if err = resp.Unmarshal(&value); err != nil {
+		return
+	}
+
+	result.Model = value.Value
+
+	return
+}
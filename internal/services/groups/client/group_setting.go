@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/graphrequest"
+)
+
+// GroupSettingClient manages directory settings for the tenant or for individual groups, and lists the templates they
+// are based on, which are not yet covered by the SDK. Where a group ID is accepted, an empty value indicates that
+// tenant-wide settings should be used.
+type GroupSettingClient struct {
+	Client *msgraph.Client
+}
+
+func NewGroupSettingClientWithBaseURI(api environments.Api) (*GroupSettingClient, error) {
+	c, err := msgraph.NewClient(api, "groupsetting", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating GroupSettingClient: %+v", err)
+	}
+
+	return &GroupSettingClient{
+		Client: c,
+	}, nil
+}
+
+type GroupSettingOperationOptions struct {
+	RetryFunc client.RequestRetryFunc
+}
+
+type GroupSettingOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.GroupSetting
+}
+
+type ListGroupSettingsOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.GroupSetting
+}
+
+type ListGroupSettingTemplatesOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.GroupSettingTemplate
+}
+
+func groupSettingsPath(groupId string) string {
+	if groupId == "" {
+		return "/groupSettings"
+	}
+	return fmt.Sprintf("%s/settings", stable.NewGroupID(groupId).ID())
+}
+
+// ListGroupSettingTemplates - List the available directory setting templates
+func (c GroupSettingClient) ListGroupSettingTemplates(ctx context.Context, options GroupSettingOperationOptions) (result ListGroupSettingTemplatesOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodGet, "/groupSettingTemplates", nil, true, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValues[stable.GroupSettingTemplate](resp)
+
+	return
+}
+
+// ListGroupSettings - List the directory settings for the tenant, or for a group
+func (c GroupSettingClient) ListGroupSettings(ctx context.Context, groupId string, options GroupSettingOperationOptions) (result ListGroupSettingsOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodGet, groupSettingsPath(groupId), nil, true, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValues[stable.GroupSetting](resp)
+
+	return
+}
+
+// CreateGroupSetting - Create a directory setting for the tenant, or for a group
+func (c GroupSettingClient) CreateGroupSetting(ctx context.Context, groupId string, input stable.GroupSetting, options GroupSettingOperationOptions) (GroupSettingOperationResponse, error) {
+	return c.execute(ctx, http.MethodPost, groupSettingsPath(groupId), input, true, options)
+}
+
+// GetGroupSetting - Retrieve a directory setting for the tenant, or for a group
+func (c GroupSettingClient) GetGroupSetting(ctx context.Context, groupId, settingId string, options GroupSettingOperationOptions) (GroupSettingOperationResponse, error) {
+	return c.execute(ctx, http.MethodGet, fmt.Sprintf("%s/%s", groupSettingsPath(groupId), settingId), nil, true, options)
+}
+
+// UpdateGroupSetting - Update a directory setting for the tenant, or for a group
+func (c GroupSettingClient) UpdateGroupSetting(ctx context.Context, groupId, settingId string, input stable.GroupSetting, options GroupSettingOperationOptions) (GroupSettingOperationResponse, error) {
+	return c.execute(ctx, http.MethodPatch, fmt.Sprintf("%s/%s", groupSettingsPath(groupId), settingId), input, false, options)
+}
+
+// DeleteGroupSetting - Delete a directory setting for the tenant, or for a group, which restores the template defaults
+func (c GroupSettingClient) DeleteGroupSetting(ctx context.Context, groupId, settingId string, options GroupSettingOperationOptions) (GroupSettingOperationResponse, error) {
+	return c.execute(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", groupSettingsPath(groupId), settingId), nil, false, options)
+}
+
+func (c GroupSettingClient) execute(ctx context.Context, method, path string, input interface{}, unmarshal bool, options GroupSettingOperationOptions) (result GroupSettingOperationResponse, err error) {
+	var resp *client.Response
+	resp, err = graphrequest.Execute(ctx, c.Client, method, path, input, false, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil || !unmarshal {
+		return
+	}
+
+	var model stable.GroupSetting
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
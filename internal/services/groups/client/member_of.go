@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/graphrequest"
+)
+
+// PrincipalMemberOfClient lists the groups, directory roles and administrative units that a user, group or service
+// principal is a member of. Direct memberships of groups are covered by the memberof SDK client, but transitive
+// memberships and those of other principal types are not yet covered by the SDK.
+type PrincipalMemberOfClient struct {
+	Client *msgraph.Client
+}
+
+func NewPrincipalMemberOfClientWithBaseURI(api environments.Api) (*PrincipalMemberOfClient, error) {
+	c, err := msgraph.NewClient(api, "principalmemberof", msgraph.VersionBeta)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating PrincipalMemberOfClient: %+v", err)
+	}
+
+	return &PrincipalMemberOfClient{
+		Client: c,
+	}, nil
+}
+
+type ListPrincipalMemberOfOperationOptions struct {
+	RetryFunc client.RequestRetryFunc
+}
+
+type ListPrincipalMemberOfOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]beta.DirectoryObject
+}
+
+// ListMemberOf - List the directory objects that a principal is a direct member of. The principal ID should be a
+// beta.GroupId, beta.ServicePrincipalId or beta.UserId.
+func (c PrincipalMemberOfClient) ListMemberOf(ctx context.Context, id resourceids.ResourceId, options ListPrincipalMemberOfOperationOptions) (ListPrincipalMemberOfOperationResponse, error) {
+	return c.listDirectoryObjects(ctx, fmt.Sprintf("%s/memberOf", id.ID()), options)
+}
+
+// ListTransitiveMemberOf - List the directory objects that a principal is a member of, either directly or through
+// nested group membership. The principal ID should be a beta.GroupId, beta.ServicePrincipalId or
+// beta.UserId.
+func (c PrincipalMemberOfClient) ListTransitiveMemberOf(ctx context.Context, id resourceids.ResourceId, options ListPrincipalMemberOfOperationOptions) (ListPrincipalMemberOfOperationResponse, error) {
+	return c.listDirectoryObjects(ctx, fmt.Sprintf("%s/transitiveMemberOf", id.ID()), options)
+}
+
+func (c PrincipalMemberOfClient) listDirectoryObjects(ctx context.Context, path string, options ListPrincipalMemberOfOperationOptions) (result ListPrincipalMemberOfOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodGet, path, nil, true, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValueImplementations(resp, beta.UnmarshalDirectoryObjectImplementation)
+
+	return
+}
@@ -15,6 +15,18 @@ const (
 
 var possibleValuesForGroupType = []string{GroupTypeDynamicMembership, GroupTypeUnified}
 
+const (
+	GroupLifecyclePolicyManagedGroupTypeAll      = "All"
+	GroupLifecyclePolicyManagedGroupTypeNone     = "None"
+	GroupLifecyclePolicyManagedGroupTypeSelected = "Selected"
+)
+
+var possibleValuesForGroupLifecyclePolicyManagedGroupType = []string{
+	GroupLifecyclePolicyManagedGroupTypeAll,
+	GroupLifecyclePolicyManagedGroupTypeNone,
+	GroupLifecyclePolicyManagedGroupTypeSelected,
+}
+
 const (
 	GroupResourceBehaviorOptionAllowOnlyMembersToPost                   = "AllowOnlyMembersToPost"
 	GroupResourceBehaviorOptionCalendarMemberReadOnly                   = "CalendarMemberReadOnly"
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
+	memberofBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/memberof"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	groupsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/groups/client"
+)
+
+func directoryObjectMembershipsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: directoryObjectMembershipsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"object_id": {
+				Description:  "The object ID of the user, group or service principal for which to retrieve memberships",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"transitive": {
+				Description: "Whether to include memberships inherited through nested groups",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"security_enabled_only": {
+				Description: "Only return groups that are security-enabled",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"assignable_to_role_only": {
+				Description: "Only return groups that can be assigned to an Azure AD role",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"group_object_ids": {
+				Description: "The object IDs of the groups that the principal is a member of",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"administrative_unit_object_ids": {
+				Description: "The object IDs of the administrative units that the principal is a member of",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"directory_role_template_ids": {
+				Description: "The template IDs of the directory roles that the principal is a member of",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func directoryObjectMembershipsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	directoryObjectClient := meta.(*clients.Client).Groups.DirectoryObjectClient
+	memberOfClient := meta.(*clients.Client).Groups.GroupMemberOfClientBeta
+	principalMemberOfClient := meta.(*clients.Client).Groups.PrincipalMemberOfClient
+
+	objectId := d.Get("object_id").(string)
+	transitive := d.Get("transitive").(bool)
+	securityEnabledOnly := d.Get("security_enabled_only").(bool)
+	assignableToRoleOnly := d.Get("assignable_to_role_only").(bool)
+
+	// Memberships are listed by principal type, so determine which one we have
+	directoryObjectId := stable.NewDirectoryObjectID(objectId)
+	resp, err := directoryObjectClient.GetDirectoryObject(ctx, directoryObjectId, directoryobject.DefaultGetDirectoryObjectOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "object_id", "%s was not found", directoryObjectId)
+		}
+		return tf.ErrorDiagPathF(err, "object_id", "Retrieving %s", directoryObjectId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", directoryObjectId)
+	}
+
+	var principalId resourceids.ResourceId
+	switch odataType := strings.TrimPrefix(pointer.From(resp.Model.DirectoryObject().ODataType), "#microsoft.graph."); odataType {
+	case "group":
+		principalId = pointer.To(beta.NewGroupID(objectId))
+	case "servicePrincipal":
+		principalId = pointer.To(beta.NewServicePrincipalID(objectId))
+	case "user":
+		principalId = pointer.To(beta.NewUserID(objectId))
+	default:
+		return tf.ErrorDiagPathF(nil, "object_id", "%s has unsupported object type %q, expected a group, service principal or user", directoryObjectId, odataType)
+	}
+
+	var memberships *[]beta.DirectoryObject
+	if groupId, ok := principalId.(*beta.GroupId); ok && !transitive {
+		resp, err := memberOfClient.ListMemberOfs(ctx, *groupId, memberofBeta.DefaultListMemberOfsOperationOptions())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Listing memberships for %s", principalId)
+		}
+		memberships = resp.Model
+	} else if transitive {
+		resp, err := principalMemberOfClient.ListTransitiveMemberOf(ctx, principalId, groupsClient.ListPrincipalMemberOfOperationOptions{})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Listing transitive memberships for %s", principalId)
+		}
+		memberships = resp.Model
+	} else {
+		resp, err := principalMemberOfClient.ListMemberOf(ctx, principalId, groupsClient.ListPrincipalMemberOfOperationOptions{})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Listing memberships for %s", principalId)
+		}
+		memberships = resp.Model
+	}
+
+	groupObjectIds := make([]string, 0)
+	administrativeUnitObjectIds := make([]string, 0)
+	directoryRoleTemplateIds := make([]string, 0)
+
+	for _, object := range pointer.From(memberships) {
+		switch o := object.(type) {
+		case beta.Group:
+			if securityEnabledOnly && !o.SecurityEnabled.GetOrZero() {
+				continue
+			}
+			if assignableToRoleOnly && !o.IsAssignableToRole.GetOrZero() {
+				continue
+			}
+			groupObjectIds = append(groupObjectIds, pointer.From(o.Id))
+		case beta.AdministrativeUnit:
+			administrativeUnitObjectIds = append(administrativeUnitObjectIds, pointer.From(o.Id))
+		case beta.DirectoryRole:
+			directoryRoleTemplateIds = append(directoryRoleTemplateIds, o.RoleTemplateId.GetOrZero())
+		}
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(fmt.Sprintf("%s/%t/%s/%s/%s", objectId, transitive, strings.Join(groupObjectIds, "/"), strings.Join(administrativeUnitObjectIds, "/"), strings.Join(directoryRoleTemplateIds, "/")))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for object IDs")
+	}
+
+	d.SetId("memberships#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "administrative_unit_object_ids", administrativeUnitObjectIds)
+	tf.Set(d, "directory_role_template_ids", directoryRoleTemplateIds)
+	tf.Set(d, "group_object_ids", groupObjectIds)
+
+	return nil
+}
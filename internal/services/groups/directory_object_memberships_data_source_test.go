@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DirectoryObjectMembershipsDataSource struct{}
+
+func TestAccDirectoryObjectMembershipsDataSource_direct(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_object_memberships", "test")
+	r := DirectoryObjectMembershipsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.direct(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("group_object_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("group_object_ids.0").MatchesOtherKey(check.That("azuread_group.inner").Key("object_id")),
+			),
+		},
+	})
+}
+
+func TestAccDirectoryObjectMembershipsDataSource_transitive(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_object_memberships", "test")
+	r := DirectoryObjectMembershipsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.transitive(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("group_object_ids.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccDirectoryObjectMembershipsDataSource_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_object_memberships", "test")
+	r := DirectoryObjectMembershipsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.servicePrincipal(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("group_object_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("group_object_ids.0").MatchesOtherKey(check.That("azuread_group.outer").Key("object_id")),
+			),
+		},
+	})
+}
+
+func (DirectoryObjectMembershipsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "inner" {
+  display_name     = "acctestGroup-%[1]d-inner"
+  security_enabled = true
+  members          = [azuread_user.test.object_id]
+}
+
+resource "azuread_group" "outer" {
+  display_name     = "acctestGroup-%[1]d-outer"
+  security_enabled = true
+  members          = [azuread_group.inner.object_id]
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r DirectoryObjectMembershipsDataSource) direct(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_directory_object_memberships" "test" {
+  object_id = azuread_user.test.object_id
+
+  depends_on = [azuread_group.outer]
+}
+`, r.template(data))
+}
+
+func (r DirectoryObjectMembershipsDataSource) transitive(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_directory_object_memberships" "test" {
+  object_id             = azuread_user.test.object_id
+  transitive            = true
+  security_enabled_only = true
+
+  depends_on = [azuread_group.outer]
+}
+`, r.template(data))
+}
+
+func (r DirectoryObjectMembershipsDataSource) servicePrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[2]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_group_member" "test" {
+  group_object_id  = azuread_group.outer.object_id
+  member_object_id = azuread_service_principal.test.object_id
+}
+
+data "azuread_directory_object_memberships" "test" {
+  object_id = azuread_service_principal.test.object_id
+
+  depends_on = [azuread_group_member.test]
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,366 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-uuid"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	groupsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/groups/client"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+const directorySettingResourceName = "azuread_directory_setting"
+
+func directorySettingResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: directorySettingResourceCreate,
+		ReadContext:   directorySettingResourceRead,
+		UpdateContext: directorySettingResourceUpdate,
+		DeleteContext: directorySettingResourceDelete,
+
+		CustomizeDiff: directorySettingResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DirectorySettingID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"template_name": {
+				Description:  "The display name of the directory setting template, e.g. `Group.Unified` or `Group.Unified.Guest`",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"group_id": {
+				Description:  "The object ID of the group to which the setting applies. When omitted, the setting applies to the whole tenant",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"values": {
+				Description: "A map of setting names to values. Settings which are not specified will use the default value from the template",
+				Type:        pluginsdk.TypeMap,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"template_id": {
+				Description: "The ID of the directory setting template",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func directorySettingResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+
+	templateName := diff.Get("template_name").(string)
+	if !pluginsdk.ValueIsNotEmptyOrUnknown(templateName) || !diff.NewValueKnown("values") {
+		return nil
+	}
+
+	template, err := directorySettingGetTemplate(ctx, client, templateName)
+	if err != nil {
+		return err
+	}
+
+	for name, value := range diff.Get("values").(map[string]interface{}) {
+		if err = directorySettingValidateValue(template, name, value.(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func directorySettingResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+
+	groupId := d.Get("group_id").(string)
+	templateName := d.Get("template_name").(string)
+
+	template, err := directorySettingGetTemplate(ctx, client, templateName)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "template_name", "Retrieving directory setting template")
+	}
+	templateId := pointer.From(template.Id)
+
+	if groupId != "" {
+		tf.LockByName(groupResourceName, groupId)
+		defer tf.UnlockByName(groupResourceName, groupId)
+	}
+
+	// Only one setting can exist for each template in the tenant, or for each group
+	listResp, err := client.ListGroupSettings(ctx, groupId, groupsClient.GroupSettingOperationOptions{})
+	if err != nil {
+		if groupId != "" && response.WasNotFound(listResp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "group_id", "Group with object ID %q was not found", groupId)
+		}
+		return tf.ErrorDiagF(err, "Checking for existing directory settings")
+	}
+	for _, existing := range pointer.From(listResp.Model) {
+		if strings.EqualFold(existing.TemplateId.GetOrZero(), templateId) && existing.Id != nil {
+			return tf.ImportAsExistsDiag(directorySettingResourceName, parse.NewDirectorySettingID(groupId, *existing.Id).String())
+		}
+	}
+
+	properties := stable.GroupSetting{
+		TemplateId: nullable.Value(templateId),
+		Values:     directorySettingExpandValues(template, d.Get("values").(map[string]interface{})),
+	}
+
+	resp, err := client.CreateGroupSetting(ctx, groupId, properties, groupsClient.GroupSettingOperationOptions{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating directory setting for template %q", templateName)
+	}
+
+	setting := resp.Model
+	if setting == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil directory setting"), "Bad API Response")
+	}
+	if setting.Id == nil || *setting.Id == "" {
+		return tf.ErrorDiagF(errors.New("API returned directory setting with nil ID"), "Bad API Response")
+	}
+
+	id := parse.NewDirectorySettingID(groupId, *setting.Id)
+	d.SetId(id.String())
+
+	// Wait for the setting to replicate
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetGroupSetting(ctx, id.GroupId, id.SettingId, groupsClient.GroupSettingOperationOptions{})
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of directory setting with ID %q", id)
+	}
+
+	return directorySettingResourceRead(ctx, d, meta)
+}
+
+func directorySettingResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+
+	id, err := parse.DirectorySettingID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Directory Setting ID %q", d.Id())
+	}
+
+	template, err := directorySettingGetTemplate(ctx, client, d.Get("template_name").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "template_name", "Retrieving directory setting template")
+	}
+
+	if id.GroupId != "" {
+		tf.LockByName(groupResourceName, id.GroupId)
+		defer tf.UnlockByName(groupResourceName, id.GroupId)
+	}
+
+	// All values are sent, so that any settings removed from the configuration are restored to their defaults
+	properties := stable.GroupSetting{
+		Values: directorySettingExpandValues(template, d.Get("values").(map[string]interface{})),
+	}
+
+	if _, err = client.UpdateGroupSetting(ctx, id.GroupId, id.SettingId, properties, groupsClient.GroupSettingOperationOptions{}); err != nil {
+		return tf.ErrorDiagF(err, "Updating directory setting with ID %q", id)
+	}
+
+	return directorySettingResourceRead(ctx, d, meta)
+}
+
+func directorySettingResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+
+	id, err := parse.DirectorySettingID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Directory Setting ID %q", d.Id())
+	}
+
+	resp, err := client.GetGroupSetting(ctx, id.GroupId, id.SettingId, groupsClient.GroupSettingOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] Directory setting with ID %q was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving directory setting with ID %q", id)
+	}
+
+	setting := resp.Model
+	if setting == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving directory setting with ID %q", id)
+	}
+
+	template, err := directorySettingGetTemplate(ctx, client, setting.DisplayName.GetOrZero())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving directory setting template for setting with ID %q", id)
+	}
+	defaults := make(map[string]string)
+	for _, v := range pointer.From(template.Values) {
+		defaults[v.Name.GetOrZero()] = v.DefaultValue.GetOrZero()
+	}
+
+	// Only track values which are configured, or which differ from the template defaults
+	configured := d.Get("values").(map[string]interface{})
+	values := make(map[string]string)
+	for _, v := range pointer.From(setting.Values) {
+		name, value := v.Name.GetOrZero(), v.Value.GetOrZero()
+		configuredValue, ok := configured[name]
+		if ok && strings.EqualFold(configuredValue.(string), value) {
+			values[name] = configuredValue.(string)
+		} else if ok || value != defaults[name] {
+			values[name] = value
+		}
+	}
+
+	tf.Set(d, "group_id", id.GroupId)
+	tf.Set(d, "template_id", setting.TemplateId.GetOrZero())
+	tf.Set(d, "template_name", setting.DisplayName.GetOrZero())
+	tf.Set(d, "values", values)
+
+	return nil
+}
+
+func directorySettingResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+
+	id, err := parse.DirectorySettingID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Directory Setting ID %q", d.Id())
+	}
+
+	if id.GroupId != "" {
+		tf.LockByName(groupResourceName, id.GroupId)
+		defer tf.UnlockByName(groupResourceName, id.GroupId)
+	}
+
+	if resp, err := client.DeleteGroupSetting(ctx, id.GroupId, id.SettingId, groupsClient.GroupSettingOperationOptions{}); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting directory setting with ID %q", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetGroupSetting(ctx, id.GroupId, id.SettingId, groupsClient.GroupSettingOperationOptions{}); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of directory setting with ID %q", id)
+	}
+
+	return nil
+}
+
+// directorySettingGetTemplate returns the directory setting template with the specified display name
+func directorySettingGetTemplate(ctx context.Context, client *groupsClient.GroupSettingClient, name string) (*stable.GroupSettingTemplate, error) {
+	resp, err := client.ListGroupSettingTemplates(ctx, groupsClient.GroupSettingOperationOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing directory setting templates: %+v", err)
+	}
+
+	names := make([]string, 0)
+	for _, template := range pointer.From(resp.Model) {
+		if strings.EqualFold(template.DisplayName.GetOrZero(), name) && template.Id != nil {
+			return &template, nil
+		}
+		names = append(names, template.DisplayName.GetOrZero())
+	}
+
+	sort.Strings(names)
+	return nil, fmt.Errorf("directory setting template %q was not found, available templates are: %s", name, strings.Join(names, ", "))
+}
+
+// directorySettingValidateValue ensures that a setting is defined by the template, and that its value can be parsed as
+// the type declared by the template
+func directorySettingValidateValue(template *stable.GroupSettingTemplate, name, value string) error {
+	names := make([]string, 0)
+	for _, v := range pointer.From(template.Values) {
+		if v.Name.GetOrZero() != name {
+			names = append(names, v.Name.GetOrZero())
+			continue
+		}
+
+		switch settingType := v.Type.GetOrZero(); settingType {
+		case "System.Boolean":
+			if !strings.EqualFold(value, "true") && !strings.EqualFold(value, "false") {
+				return fmt.Errorf("value for setting %q must be `true` or `false`, got %q", name, value)
+			}
+		case "System.Guid":
+			if value != "" {
+				if _, err := uuid.ParseUUID(value); err != nil {
+					return fmt.Errorf("value for setting %q must be a UUID, got %q", name, value)
+				}
+			}
+		case "System.Int32":
+			if _, err := strconv.ParseInt(value, 10, 32); err != nil {
+				return fmt.Errorf("value for setting %q must be an integer, got %q", name, value)
+			}
+		}
+
+		return nil
+	}
+
+	sort.Strings(names)
+	return fmt.Errorf("setting %q is not defined by directory setting template %q, valid settings are: %s", name, template.DisplayName.GetOrZero(), strings.Join(names, ", "))
+}
+
+// directorySettingExpandValues returns all the values defined by a template, using the configured value for each
+// setting where present, or otherwise the default value
+func directorySettingExpandValues(template *stable.GroupSettingTemplate, configured map[string]interface{}) *[]stable.SettingValue {
+	result := make([]stable.SettingValue, 0)
+	for _, v := range pointer.From(template.Values) {
+		name := v.Name.GetOrZero()
+		value := v.DefaultValue.GetOrZero()
+		if configuredValue, ok := configured[name]; ok {
+			value = configuredValue.(string)
+		}
+		result = append(result, stable.SettingValue{
+			Name:  nullable.Value(name),
+			Value: nullable.Value(value),
+		})
+	}
+	return &result
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	groupsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/groups/client"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+type DirectorySettingResource struct{}
+
+// Only one tenant-wide setting can exist for each template, so tenant-wide tests must not run in parallel
+
+func TestAccDirectorySetting_tenant(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_setting", "test")
+	r := DirectorySettingResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.tenant(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("template_id").IsUuid(),
+				check.That(data.ResourceName).Key("values.%").HasValue("2"),
+				check.That(data.ResourceName).Key("values.PrefixSuffixNamingRequirement").HasValue("GRP_[GroupName]"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tenantUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("values.%").HasValue("1"),
+				check.That(data.ResourceName).Key("values.CustomBlockedWordsList").HasValue("acctest,contoso"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDirectorySetting_group(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_setting", "test")
+	r := DirectorySettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.group(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_id").IsUuid(),
+				check.That(data.ResourceName).Key("values.AllowToAddGuests").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDirectorySetting_invalidValue(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_setting", "test")
+	r := DirectorySettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidValue(data),
+			ExpectError: regexp.MustCompile("value for setting \"EnableGroupCreation\" must be `true` or `false`"),
+		},
+	})
+}
+
+func (r DirectorySettingResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupSettingClient
+
+	id, err := parse.DirectorySettingID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Directory Setting ID: %v", err)
+	}
+
+	resp, err := client.GetGroupSetting(ctx, id.GroupId, id.SettingId, groupsClient.GroupSettingOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve directory setting with ID %q: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (DirectorySettingResource) tenant(data acceptance.TestData) string {
+	return `
+resource "azuread_directory_setting" "test" {
+  template_name = "Group.Unified"
+
+  values = {
+    EnableGroupCreation           = "true"
+    PrefixSuffixNamingRequirement = "GRP_[GroupName]"
+  }
+}
+`
+}
+
+func (DirectorySettingResource) tenantUpdated(data acceptance.TestData) string {
+	return `
+resource "azuread_directory_setting" "test" {
+  template_name = "Group.Unified"
+
+  values = {
+    CustomBlockedWordsList = "acctest,contoso"
+  }
+}
+`
+}
+
+func (DirectorySettingResource) group(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name     = "acctestGroupSetting-%[1]d"
+  mail_enabled     = true
+  mail_nickname    = "acctestGroupSetting-%[1]d"
+  security_enabled = false
+  types            = ["Unified"]
+}
+
+resource "azuread_directory_setting" "test" {
+  template_name = "Group.Unified.Guest"
+  group_id      = azuread_group.test.object_id
+
+  values = {
+    AllowToAddGuests = "false"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (DirectorySettingResource) invalidValue(data acceptance.TestData) string {
+	return `
+resource "azuread_directory_setting" "test" {
+  template_name = "Group.Unified"
+
+  values = {
+    EnableGroupCreation = "sometimes"
+  }
+}
+`
+}
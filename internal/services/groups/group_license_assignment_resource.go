@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	groupBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/group"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+func groupLicenseAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: groupLicenseAssignmentResourceCreate,
+		ReadContext:   groupLicenseAssignmentResourceRead,
+		UpdateContext: groupLicenseAssignmentResourceUpdate,
+		DeleteContext: groupLicenseAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.GroupLicenseID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"group_object_id": {
+				Description:  "The object ID of the group to which the license should be assigned",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"sku_id": {
+				Description:  "The unique identifier for the SKU to assign",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"disabled_plans": {
+				Description: "A set of service plan IDs to disable for the assigned license",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+		},
+	}
+}
+
+func groupLicenseAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupClientBeta
+
+	groupId := beta.NewGroupID(d.Get("group_object_id").(string))
+	skuId := d.Get("sku_id").(string)
+	resourceId := parse.NewGroupLicenseID(groupId.GroupId, skuId)
+
+	tf.LockByName(groupResourceName, groupId.GroupId)
+	defer tf.UnlockByName(groupResourceName, groupId.GroupId)
+
+	existing, err := groupGetAssignedLicense(ctx, client, groupId, skuId)
+	if err != nil {
+		if errors.Is(err, errGroupNotFound) {
+			return tf.ErrorDiagPathF(nil, "group_object_id", "%s was not found", groupId)
+		}
+		return tf.ErrorDiagF(err, "Retrieving assigned licenses for %s", groupId)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsDiag("azuread_group_license_assignment", resourceId.String())
+	}
+
+	if diags := groupAssignLicense(ctx, client, groupId, skuId, tf.ExpandStringSlice(d.Get("disabled_plans").(*pluginsdk.Set).List())); diags != nil {
+		return diags
+	}
+
+	d.SetId(resourceId.String())
+
+	return groupLicenseAssignmentResourceRead(ctx, d, meta)
+}
+
+func groupLicenseAssignmentResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupClientBeta
+
+	resourceId, err := parse.GroupLicenseID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group License ID %q", d.Id())
+	}
+	groupId := beta.NewGroupID(resourceId.GroupId)
+
+	tf.LockByName(groupResourceName, groupId.GroupId)
+	defer tf.UnlockByName(groupResourceName, groupId.GroupId)
+
+	// Assigning a license that is already assigned replaces its disabled plans
+	if diags := groupAssignLicense(ctx, client, groupId, resourceId.SkuId, tf.ExpandStringSlice(d.Get("disabled_plans").(*pluginsdk.Set).List())); diags != nil {
+		return diags
+	}
+
+	return groupLicenseAssignmentResourceRead(ctx, d, meta)
+}
+
+func groupLicenseAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupClientBeta
+
+	resourceId, err := parse.GroupLicenseID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group License ID %q", d.Id())
+	}
+	groupId := beta.NewGroupID(resourceId.GroupId)
+
+	license, err := groupGetAssignedLicense(ctx, client, groupId, resourceId.SkuId)
+	if err != nil && !errors.Is(err, errGroupNotFound) {
+		return tf.ErrorDiagF(err, "Retrieving assigned licenses for %s", groupId)
+	}
+	if license == nil {
+		log.Printf("[DEBUG] License %q for %s was not found - removing from state!", resourceId.SkuId, groupId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "disabled_plans", tf.FlattenStringSlicePtr(license.DisabledPlans))
+	tf.Set(d, "group_object_id", resourceId.GroupId)
+	tf.Set(d, "sku_id", resourceId.SkuId)
+
+	return nil
+}
+
+func groupLicenseAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupClientBeta
+
+	resourceId, err := parse.GroupLicenseID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group License ID %q", d.Id())
+	}
+	groupId := beta.NewGroupID(resourceId.GroupId)
+
+	tf.LockByName(groupResourceName, groupId.GroupId)
+	defer tf.UnlockByName(groupResourceName, groupId.GroupId)
+
+	properties := groupBeta.AssignLicenseRequest{
+		AddLicenses:    &[]beta.AssignedLicense{},
+		RemoveLicenses: &[]string{resourceId.SkuId},
+	}
+	if resp, err := client.AssignLicense(ctx, groupId, properties, groupBeta.DefaultAssignLicenseOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing license %q from %s", resourceId.SkuId, groupId)
+	}
+
+	// Wait for the license to be removed
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		license, err := groupGetAssignedLicense(ctx, client, groupId, resourceId.SkuId)
+		if err != nil {
+			if errors.Is(err, errGroupNotFound) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(license != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of license %q from %s", resourceId.SkuId, groupId)
+	}
+
+	return nil
+}
+
+var errGroupNotFound = errors.New("group was not found")
+
+// groupGetAssignedLicense returns the license with the specified SKU ID assigned to a group, or nil if the SKU is not
+// assigned. When the group does not exist, errGroupNotFound is returned.
+func groupGetAssignedLicense(ctx context.Context, client *groupBeta.GroupClient, id beta.GroupId, skuId string) (*beta.AssignedLicense, error) {
+	options := groupBeta.GetGroupOperationOptions{
+		Select: &[]string{"assignedLicenses", "id"},
+	}
+	resp, err := client.GetGroup(ctx, id, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, errGroupNotFound
+		}
+		return nil, err
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", id)
+	}
+
+	if resp.Model.AssignedLicenses != nil {
+		for _, license := range *resp.Model.AssignedLicenses {
+			if strings.EqualFold(license.SkuId.GetOrZero(), skuId) {
+				return &license, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// groupAssignLicense assigns a license to a group, then waits for the assignment to be reflected when reading the group
+func groupAssignLicense(ctx context.Context, client *groupBeta.GroupClient, id beta.GroupId, skuId string, disabledPlans []string) pluginsdk.Diagnostics {
+	properties := groupBeta.AssignLicenseRequest{
+		AddLicenses: &[]beta.AssignedLicense{{
+			SkuId:         nullable.Value(skuId),
+			DisabledPlans: &disabledPlans,
+		}},
+		RemoveLicenses: &[]string{},
+	}
+
+	if _, err := client.AssignLicense(ctx, id, properties, groupBeta.DefaultAssignLicenseOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Assigning license %q to %s", skuId, id)
+	}
+
+	if err := consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		license, err := groupGetAssignedLicense(ctx, client, id, skuId)
+		if err != nil {
+			return nil, err
+		}
+		if license == nil {
+			return pointer.To(false), nil
+		}
+		return pointer.To(len(pointer.From(license.DisabledPlans)) == len(disabledPlans)), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for license %q to be assigned to %s", skuId, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	groupBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/group"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+type GroupLicenseAssignmentResource struct {
+	SkuPartNumber string
+}
+
+func TestAccGroupLicenseAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_license_assignment", "test")
+	r := GroupLicenseAssignmentResource{SkuPartNumber: os.Getenv("ARM_TEST_LICENSE_SKU_PART_NUMBER")}
+	if r.SkuPartNumber == "" {
+		t.Skip("ARM_TEST_LICENSE_SKU_PART_NUMBER must be set to run this test")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("sku_id").IsUuid(),
+				check.That(data.ResourceName).Key("disabled_plans.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupLicenseAssignment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_license_assignment", "test")
+	r := GroupLicenseAssignmentResource{SkuPartNumber: os.Getenv("ARM_TEST_LICENSE_SKU_PART_NUMBER")}
+	if r.SkuPartNumber == "" {
+		t.Skip("ARM_TEST_LICENSE_SKU_PART_NUMBER must be set to run this test")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.disabledPlans(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disabled_plans.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disabled_plans.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r GroupLicenseAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupClientBeta
+
+	id, err := parse.GroupLicenseID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Group License ID: %v", err)
+	}
+
+	options := groupBeta.GetGroupOperationOptions{
+		Select: &[]string{"assignedLicenses"},
+	}
+	resp, err := client.GetGroup(ctx, beta.NewGroupID(id.GroupId), options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve group with object ID %q: %+v", id.GroupId, err)
+	}
+
+	if resp.Model != nil && resp.Model.AssignedLicenses != nil {
+		for _, license := range *resp.Model.AssignedLicenses {
+			if strings.EqualFold(license.SkuId.GetOrZero(), id.SkuId) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r GroupLicenseAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_subscribed_skus" "test" {
+  sku_part_numbers = ["%[2]s"]
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroupLicense-%[1]d"
+  security_enabled = true
+}
+`, data.RandomInteger, r.SkuPartNumber)
+}
+
+func (r GroupLicenseAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_license_assignment" "test" {
+  group_object_id = azuread_group.test.object_id
+  sku_id          = data.azuread_subscribed_skus.test.skus[0].sku_id
+}
+`, r.template(data))
+}
+
+func (r GroupLicenseAssignmentResource) disabledPlans(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_license_assignment" "test" {
+  group_object_id = azuread_group.test.object_id
+  sku_id          = data.azuread_subscribed_skus.test.skus[0].sku_id
+  disabled_plans  = [data.azuread_subscribed_skus.test.skus[0].service_plans[0].service_plan_id]
+}
+`, r.template(data))
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	groupsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/groups/client"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+func groupLifecyclePolicyAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: groupLifecyclePolicyAssignmentResourceCreate,
+		ReadContext:   groupLifecyclePolicyAssignmentResourceRead,
+		DeleteContext: groupLifecyclePolicyAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.GroupLifecyclePolicyAssignmentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"group_lifecycle_policy_id": {
+				Description:  "The ID of the group lifecycle policy",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"group_object_id": {
+				Description:  "The object ID of the Microsoft 365 group to which the policy should apply",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func groupLifecyclePolicyAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	id := parse.NewGroupLifecyclePolicyAssignmentID(d.Get("group_lifecycle_policy_id").(string), d.Get("group_object_id").(string))
+	groupId := stable.NewGroupID(id.GroupId)
+
+	tf.LockByName(groupLifecyclePolicyResourceName, id.PolicyId)
+	defer tf.UnlockByName(groupLifecyclePolicyResourceName, id.PolicyId)
+
+	policyResp, err := client.GetGroupLifecyclePolicy(ctx, id.PolicyId, groupsClient.GroupLifecyclePolicyOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(policyResp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "group_lifecycle_policy_id", "Group lifecycle policy with ID %q was not found", id.PolicyId)
+		}
+		return tf.ErrorDiagPathF(err, "group_lifecycle_policy_id", "Retrieving group lifecycle policy with ID %q", id.PolicyId)
+	}
+	if policyResp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving group lifecycle policy with ID %q", id.PolicyId)
+	}
+
+	// Groups can only be added to a policy that applies to selected groups
+	if managedGroupTypes := policyResp.Model.ManagedGroupTypes.GetOrZero(); managedGroupTypes != GroupLifecyclePolicyManagedGroupTypeSelected {
+		return tf.ErrorDiagPathF(nil, "group_lifecycle_policy_id", "Groups can only be assigned to a group lifecycle policy with `managed_group_types` set to %q, but the policy with ID %q has %q", GroupLifecyclePolicyManagedGroupTypeSelected, id.PolicyId, managedGroupTypes)
+	}
+
+	assigned, err := groupHasLifecyclePolicy(ctx, client, groupId, id.PolicyId)
+	if err != nil {
+		if errors.Is(err, errGroupNotFound) {
+			return tf.ErrorDiagPathF(nil, "group_object_id", "%s was not found", groupId)
+		}
+		return tf.ErrorDiagF(err, "Retrieving lifecycle policies for %s", groupId)
+	}
+	if assigned {
+		return tf.ImportAsExistsDiag("azuread_group_lifecycle_policy_assignment", id.String())
+	}
+
+	resp, err := client.AddGroup(ctx, id.PolicyId, id.GroupId, groupsClient.GroupLifecyclePolicyOperationOptions{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Adding %s to group lifecycle policy with ID %q", groupId, id.PolicyId)
+	}
+	if !pointer.From(resp.Model) {
+		return tf.ErrorDiagF(errors.New("API indicated the group was not added"), "Adding %s to group lifecycle policy with ID %q", groupId, id.PolicyId)
+	}
+
+	// Wait for the assignment to be reflected
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		assigned, err := groupHasLifecyclePolicy(ctx, client, groupId, id.PolicyId)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(assigned), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for %s to be added to group lifecycle policy with ID %q", groupId, id.PolicyId)
+	}
+
+	d.SetId(id.String())
+
+	return groupLifecyclePolicyAssignmentResourceRead(ctx, d, meta)
+}
+
+func groupLifecyclePolicyAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	id, err := parse.GroupLifecyclePolicyAssignmentID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Lifecycle Policy Assignment ID %q", d.Id())
+	}
+	groupId := stable.NewGroupID(id.GroupId)
+
+	assigned, err := groupHasLifecyclePolicy(ctx, client, groupId, id.PolicyId)
+	if err != nil && !errors.Is(err, errGroupNotFound) {
+		return tf.ErrorDiagF(err, "Retrieving lifecycle policies for %s", groupId)
+	}
+	if !assigned {
+		log.Printf("[DEBUG] Group lifecycle policy %q for %s was not found - removing from state!", id.PolicyId, groupId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "group_lifecycle_policy_id", id.PolicyId)
+	tf.Set(d, "group_object_id", id.GroupId)
+
+	return nil
+}
+
+func groupLifecyclePolicyAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	id, err := parse.GroupLifecyclePolicyAssignmentID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Lifecycle Policy Assignment ID %q", d.Id())
+	}
+	groupId := stable.NewGroupID(id.GroupId)
+
+	tf.LockByName(groupLifecyclePolicyResourceName, id.PolicyId)
+	defer tf.UnlockByName(groupLifecyclePolicyResourceName, id.PolicyId)
+
+	if resp, err := client.RemoveGroup(ctx, id.PolicyId, id.GroupId, groupsClient.GroupLifecyclePolicyOperationOptions{}); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing %s from group lifecycle policy with ID %q", groupId, id.PolicyId)
+	}
+
+	// Wait for the assignment to be removed
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		assigned, err := groupHasLifecyclePolicy(ctx, client, groupId, id.PolicyId)
+		if err != nil {
+			if errors.Is(err, errGroupNotFound) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(assigned), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of %s from group lifecycle policy with ID %q", groupId, id.PolicyId)
+	}
+
+	return nil
+}
+
+// groupHasLifecyclePolicy returns whether the specified lifecycle policy applies to a group. When the group does not
+// exist, errGroupNotFound is returned.
+func groupHasLifecyclePolicy(ctx context.Context, client *groupsClient.GroupLifecyclePolicyClient, id stable.GroupId, policyId string) (bool, error) {
+	resp, err := client.ListGroupLifecyclePoliciesForGroup(ctx, id, groupsClient.GroupLifecyclePolicyOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return false, errGroupNotFound
+		}
+		return false, err
+	}
+
+	for _, policy := range pointer.From(resp.Model) {
+		if strings.EqualFold(pointer.From(policy.Id), policyId) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	groupsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/groups/client"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+type GroupLifecyclePolicyAssignmentResource struct{}
+
+func TestAccGroupLifecyclePolicyAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy_assignment", "test")
+	r := GroupLifecyclePolicyAssignmentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_lifecycle_policy_id").IsUuid(),
+				check.That(data.ResourceName).Key("group_object_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupLifecyclePolicyAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy_assignment", "test")
+	r := GroupLifecyclePolicyAssignmentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r GroupLifecyclePolicyAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupLifecyclePolicyClient
+
+	id, err := parse.GroupLifecyclePolicyAssignmentID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Group Lifecycle Policy Assignment ID: %v", err)
+	}
+
+	resp, err := client.ListGroupLifecyclePoliciesForGroup(ctx, stable.NewGroupID(id.GroupId), groupsClient.GroupLifecyclePolicyOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve lifecycle policies for group with object ID %q: %+v", id.GroupId, err)
+	}
+
+	for _, policy := range pointer.From(resp.Model) {
+		if strings.EqualFold(pointer.From(policy.Id), id.PolicyId) {
+			return pointer.To(true), nil
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (GroupLifecyclePolicyAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group_lifecycle_policy" "test" {
+  group_lifetime_in_days = 180
+  managed_group_types    = "Selected"
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroupLifecycle-%[1]d"
+  mail_enabled     = true
+  mail_nickname    = "acctestGroupLifecycle-%[1]d"
+  security_enabled = false
+  types            = ["Unified"]
+}
+`, data.RandomInteger)
+}
+
+func (r GroupLifecyclePolicyAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_lifecycle_policy_assignment" "test" {
+  group_lifecycle_policy_id = azuread_group_lifecycle_policy.test.id
+  group_object_id           = azuread_group.test.object_id
+}
+`, r.template(data))
+}
+
+func (r GroupLifecyclePolicyAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_lifecycle_policy_assignment" "import" {
+  group_lifecycle_policy_id = azuread_group_lifecycle_policy_assignment.test.group_lifecycle_policy_id
+  group_object_id           = azuread_group_lifecycle_policy_assignment.test.group_object_id
+}
+`, r.basic(data))
+}
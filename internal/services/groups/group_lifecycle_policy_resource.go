@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-uuid"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	groupsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/groups/client"
+)
+
+const groupLifecyclePolicyResourceName = "azuread_group_lifecycle_policy"
+
+func groupLifecyclePolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: groupLifecyclePolicyResourceCreate,
+		ReadContext:   groupLifecyclePolicyResourceRead,
+		UpdateContext: groupLifecyclePolicyResourceUpdate,
+		DeleteContext: groupLifecyclePolicyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"group_lifetime_in_days": {
+				Description:  "The number of days before a group expires and needs to be renewed",
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(31),
+			},
+
+			"managed_group_types": {
+				Description:  "The Microsoft 365 groups to which the policy applies",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(possibleValuesForGroupLifecyclePolicyManagedGroupType, false),
+			},
+
+			"alternate_notification_emails": {
+				Description: "A set of email addresses to notify about expiring groups which have no owners",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsEmailAddress,
+				},
+			},
+		},
+	}
+}
+
+func groupLifecyclePolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	// Only one lifecycle policy can exist in a tenant
+	listResp, err := client.ListGroupLifecyclePolicies(ctx, groupsClient.GroupLifecyclePolicyOperationOptions{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing group lifecycle policy")
+	}
+	for _, existing := range pointer.From(listResp.Model) {
+		if existing.Id != nil {
+			return tf.ImportAsExistsDiag(groupLifecyclePolicyResourceName, *existing.Id)
+		}
+	}
+
+	resp, err := client.CreateGroupLifecyclePolicy(ctx, expandGroupLifecyclePolicy(d), groupsClient.GroupLifecyclePolicyOperationOptions{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating group lifecycle policy")
+	}
+
+	policy := resp.Model
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil group lifecycle policy"), "Bad API Response")
+	}
+	if policy.Id == nil || *policy.Id == "" {
+		return tf.ErrorDiagF(errors.New("API returned group lifecycle policy with nil ID"), "Bad API Response")
+	}
+
+	d.SetId(*policy.Id)
+
+	// Wait for the policy to replicate
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetGroupLifecyclePolicy(ctx, d.Id(), groupsClient.GroupLifecyclePolicyOperationOptions{})
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of group lifecycle policy with ID %q", d.Id())
+	}
+
+	return groupLifecyclePolicyResourceRead(ctx, d, meta)
+}
+
+func groupLifecyclePolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	tf.LockByName(groupLifecyclePolicyResourceName, d.Id())
+	defer tf.UnlockByName(groupLifecyclePolicyResourceName, d.Id())
+
+	if _, err := client.UpdateGroupLifecyclePolicy(ctx, d.Id(), expandGroupLifecyclePolicy(d), groupsClient.GroupLifecyclePolicyOperationOptions{}); err != nil {
+		return tf.ErrorDiagF(err, "Updating group lifecycle policy with ID %q", d.Id())
+	}
+
+	return groupLifecyclePolicyResourceRead(ctx, d, meta)
+}
+
+func groupLifecyclePolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	resp, err := client.GetGroupLifecyclePolicy(ctx, d.Id(), groupsClient.GroupLifecyclePolicyOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] Group lifecycle policy with ID %q was not found - removing from state!", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving group lifecycle policy with ID %q", d.Id())
+	}
+
+	policy := resp.Model
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving group lifecycle policy with ID %q", d.Id())
+	}
+
+	alternateNotificationEmails := make([]string, 0)
+	for _, email := range strings.Split(policy.AlternateNotificationEmails.GetOrZero(), ";") {
+		if email = strings.TrimSpace(email); email != "" {
+			alternateNotificationEmails = append(alternateNotificationEmails, email)
+		}
+	}
+
+	tf.Set(d, "alternate_notification_emails", alternateNotificationEmails)
+	tf.Set(d, "group_lifetime_in_days", int(policy.GroupLifetimeInDays.GetOrZero()))
+	tf.Set(d, "managed_group_types", policy.ManagedGroupTypes.GetOrZero())
+
+	return nil
+}
+
+func groupLifecyclePolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	tf.LockByName(groupLifecyclePolicyResourceName, d.Id())
+	defer tf.UnlockByName(groupLifecyclePolicyResourceName, d.Id())
+
+	if resp, err := client.DeleteGroupLifecyclePolicy(ctx, d.Id(), groupsClient.GroupLifecyclePolicyOperationOptions{}); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting group lifecycle policy with ID %q", d.Id())
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetGroupLifecyclePolicy(ctx, d.Id(), groupsClient.GroupLifecyclePolicyOperationOptions{}); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of group lifecycle policy with ID %q", d.Id())
+	}
+
+	return nil
+}
+
+func expandGroupLifecyclePolicy(d *pluginsdk.ResourceData) stable.GroupLifecyclePolicy {
+	return stable.GroupLifecyclePolicy{
+		AlternateNotificationEmails: nullable.Value(strings.Join(tf.ExpandStringSlice(d.Get("alternate_notification_emails").(*pluginsdk.Set).List()), ";")),
+		GroupLifetimeInDays:         nullable.Value(int64(d.Get("group_lifetime_in_days").(int))),
+		ManagedGroupTypes:           nullable.Value(d.Get("managed_group_types").(string)),
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	groupsClient "github.com/valiparsa/terraform-provider-azuread/internal/services/groups/client"
+)
+
+type GroupLifecyclePolicyResource struct{}
+
+// Only one group lifecycle policy can exist in a tenant, so these tests must not run in parallel
+
+func TestAccGroupLifecyclePolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy", "test")
+	r := GroupLifecyclePolicyResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_lifetime_in_days").HasValue("180"),
+				check.That(data.ResourceName).Key("managed_group_types").HasValue("All"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupLifecyclePolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy", "test")
+	r := GroupLifecyclePolicyResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("alternate_notification_emails.#").HasValue("2"),
+				check.That(data.ResourceName).Key("group_lifetime_in_days").HasValue("365"),
+				check.That(data.ResourceName).Key("managed_group_types").HasValue("Selected"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("alternate_notification_emails.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r GroupLifecyclePolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupLifecyclePolicyClient
+
+	resp, err := client.GetGroupLifecyclePolicy(ctx, state.ID, groupsClient.GroupLifecyclePolicyOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve group lifecycle policy with ID %q: %+v", state.ID, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (GroupLifecyclePolicyResource) basic(data acceptance.TestData) string {
+	return `
+resource "azuread_group_lifecycle_policy" "test" {
+  group_lifetime_in_days = 180
+  managed_group_types    = "All"
+}
+`
+}
+
+func (GroupLifecyclePolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_group_lifecycle_policy" "test" {
+  group_lifetime_in_days = 365
+  managed_group_types    = "Selected"
+
+  alternate_notification_emails = [
+    "acctest-lifecycle-%[1]s-1@${data.azuread_domains.test.domains.0.domain_name}",
+    "acctest-lifecycle-%[1]s-2@${data.azuread_domains.test.domains.0.domain_name}",
+  ]
+}
+`, data.RandomString)
+}
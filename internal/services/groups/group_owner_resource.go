@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	groupBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/group"
+	ownerBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/owner"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+func groupOwnerResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: groupOwnerResourceCreate,
+		ReadContext:   groupOwnerResourceRead,
+		DeleteContext: groupOwnerResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.GroupOwnerID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"group_object_id": {
+				Description:  "The object ID of the group you want to add the owner to",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"owner_object_id": {
+				Description:  "The object ID of the principal you want to add as an owner of the group. Supported object types are Users or Service Principals",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func groupOwnerResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupClientBeta
+	ownerClient := meta.(*clients.Client).Groups.GroupOwnerClientBeta
+
+	id := beta.NewGroupIdOwnerID(d.Get("group_object_id").(string), d.Get("owner_object_id").(string))
+	groupId := beta.NewGroupID(id.GroupId)
+	resourceId := parse.NewGroupOwnerID(id.GroupId, id.DirectoryObjectId)
+
+	tf.LockByName(groupResourceName, id.GroupId)
+	defer tf.UnlockByName(groupResourceName, id.GroupId)
+
+	if resp, err := client.GetGroup(ctx, groupId, groupBeta.DefaultGetGroupOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "group_object_id", "%s was not found", groupId)
+		}
+		return tf.ErrorDiagPathF(err, "group_object_id", "Retrieving %s", groupId)
+	}
+
+	if owner, err := groupGetOwner(ctx, ownerClient, id); err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing %s", id)
+	} else if owner != nil {
+		return tf.ImportAsExistsDiag("azuread_group_owner", resourceId.String())
+	}
+
+	ownerRef := beta.ReferenceCreate{
+		ODataId: pointer.To(client.Client.BaseUri + beta.NewDirectoryObjectID(id.DirectoryObjectId).ID()),
+	}
+
+	if _, err := ownerClient.AddOwnerRef(ctx, groupId, ownerRef, ownerBeta.DefaultAddOwnerRefOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Adding %s", id)
+	}
+
+	// Wait for ownership to reflect
+	if err := consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		if owner, err := groupGetOwner(ctx, ownerClient, id); err != nil {
+			return nil, err
+		} else if owner == nil {
+			return pointer.To(false), nil
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for %s", id)
+	}
+
+	d.SetId(resourceId.String())
+
+	return groupOwnerResourceRead(ctx, d, meta)
+}
+
+func groupOwnerResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupOwnerClientBeta
+
+	resourceId, err := parse.GroupOwnerID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Owner ID %q", d.Id())
+	}
+	id := beta.NewGroupIdOwnerID(resourceId.GroupId, resourceId.OwnerId)
+
+	if owner, err := groupGetOwner(ctx, client, id); err != nil {
+		return tf.ErrorDiagF(err, "Retrieving owner %q for group with object ID: %q", id.DirectoryObjectId, id.GroupId)
+	} else if owner == nil {
+		log.Printf("[DEBUG] Owner %q was not found in group %q - removing from state!", id.DirectoryObjectId, id.GroupId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "group_object_id", id.GroupId)
+	tf.Set(d, "owner_object_id", id.DirectoryObjectId)
+
+	return nil
+}
+
+func groupOwnerResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupOwnerClientBeta
+
+	resourceId, err := parse.GroupOwnerID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Owner ID %q", d.Id())
+	}
+	id := beta.NewGroupIdOwnerID(resourceId.GroupId, resourceId.OwnerId)
+
+	tf.LockByName(groupResourceName, id.GroupId)
+	defer tf.UnlockByName(groupResourceName, id.GroupId)
+
+	if _, err := client.RemoveOwnerRef(ctx, id, ownerBeta.DefaultRemoveOwnerRefOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Removing %s", id)
+	}
+
+	// Wait for ownership link to be deleted
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if owner, err := groupGetOwner(ctx, client, id); err != nil {
+			return nil, err
+		} else if owner == nil {
+			return pointer.To(false), nil
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of %s", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	ownerBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/owner"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+type GroupOwnerResource struct{}
+
+func TestAccGroupOwner_user(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_owner", "test")
+	r := GroupOwnerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.user(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("owner_object_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupOwner_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_owner", "test")
+	r := GroupOwnerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.servicePrincipal(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("owner_object_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupOwner_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_owner", "test")
+	r := GroupOwnerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.user(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r GroupOwnerResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupOwnerClientBeta
+
+	id, err := parse.GroupOwnerID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Group Owner ID: %v", err)
+	}
+
+	resp, err := client.ListOwners(ctx, beta.NewGroupID(id.GroupId), ownerBeta.DefaultListOwnersOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve group owner %q (group ID: %q): %+v", id.OwnerId, id.GroupId, err)
+	}
+
+	if resp.Model != nil {
+		for _, owner := range *resp.Model {
+			if strings.EqualFold(pointer.From(owner.DirectoryObject().Id), id.OwnerId) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (GroupOwnerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_client_config" "test" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+  owners           = [data.azuread_client_config.test.object_id]
+
+  lifecycle {
+    ignore_changes = [owners]
+  }
+}
+`, data.RandomInteger)
+}
+
+func (r GroupOwnerResource) user(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[2]d"
+  password            = "%[3]s"
+}
+
+resource "azuread_group_owner" "test" {
+  group_object_id = azuread_group.test.object_id
+  owner_object_id = azuread_user.test.object_id
+}
+`, r.template(data), data.RandomInteger, data.RandomPassword)
+}
+
+func (r GroupOwnerResource) servicePrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[2]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_group_owner" "test" {
+  group_object_id = azuread_group.test.object_id
+  owner_object_id = azuread_service_principal.test.object_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GroupOwnerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_owner" "import" {
+  group_object_id = azuread_group_owner.test.group_object_id
+  owner_object_id = azuread_group_owner.test.owner_object_id
+}
+`, r.user(data))
+}
@@ -139,7 +139,7 @@ func groupResource() *pluginsdk.Resource {
 							Description:  "Rule to determine members for a dynamic group. Required when `group_types` contains 'DynamicMembership'",
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringLenBetween(0, 3072),
+							ValidateFunc: validation.All(validation.StringLenBetween(0, 3072), validation.DynamicMembershipRule),
 						},
 					},
 				},
@@ -377,6 +377,18 @@ func groupResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDif
 		return fmt.Errorf("`dynamic_membership` must be specified when `types` contains %q", GroupTypeDynamicMembership)
 	}
 
+	// Microsoft 365 groups can only contain users, so ensure the membership rule doesn't select devices
+	if rule := diff.Get("dynamic_membership.0.rule"); slices.Contains(groupTypes, GroupTypeUnified) && pluginsdk.ValueIsNotEmptyOrUnknown(rule) {
+		// Rules using unrecognised syntax are left for the API to validate, consistent with the rule's ValidateFunc
+		if _, err := validation.ParseDynamicMembershipRule(rule.(string), validation.DynamicMembershipRuleMemberTypeUser); err != nil {
+			var ruleErr validation.DynamicMembershipRuleError
+			if !errors.As(err, &ruleErr) || !ruleErr.Unrecognised {
+				return fmt.Errorf("`dynamic_membership.0.rule` is not valid for unified groups, which may only contain users: %v", err)
+			}
+			log.Printf("[WARN] Could not validate `dynamic_membership.0.rule` for unified group: %v", err)
+		}
+	}
+
 	if mailEnabled && !slices.Contains(groupTypes, GroupTypeUnified) {
 		return fmt.Errorf("`types` must contain %q for mail-enabled groups", GroupTypeUnified)
 	}
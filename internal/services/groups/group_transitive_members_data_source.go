@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	transitivememberBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/transitivemember"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func groupTransitiveMembersDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: groupTransitiveMembersDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"group_object_id": {
+				Description:  "The object ID of the group",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"member_types": {
+				Description: "Only return members of these types. Possible values are `device`, `group`, `orgContact`, `servicePrincipal` and `user`",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"device", "group", "orgContact", "servicePrincipal", "user"}, false),
+				},
+			},
+
+			"object_ids": {
+				Description: "The object IDs of the transitive members of the group",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"members": {
+				Description: "A list of the transitive members of the group",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"display_name": {
+							Description: "The display name of the member",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"object_id": {
+							Description: "The object ID of the member",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"type": {
+							Description: "The type of the member, for example `group`, `servicePrincipal` or `user`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func groupTransitiveMembersDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupTransitiveMemberClientBeta
+
+	id := beta.NewGroupID(d.Get("group_object_id").(string))
+	memberTypes := tf.ExpandStringSlice(d.Get("member_types").(*pluginsdk.Set).List())
+
+	resp, err := client.ListTransitiveMembers(ctx, id, transitivememberBeta.DefaultListTransitiveMembersOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "group_object_id", "%s was not found", id)
+		}
+		return tf.ErrorDiagF(err, "Listing transitive members for %s", id)
+	}
+
+	members := make([]map[string]interface{}, 0)
+	objectIds := make([]string, 0)
+
+	for _, object := range pointer.From(resp.Model) {
+		memberType := strings.TrimPrefix(pointer.From(object.DirectoryObject().ODataType), "#microsoft.graph.")
+
+		if len(memberTypes) > 0 {
+			found := false
+			for _, t := range memberTypes {
+				if strings.EqualFold(t, memberType) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+
+		var displayName string
+		switch o := object.(type) {
+		case beta.Device:
+			displayName = o.DisplayName.GetOrZero()
+		case beta.Group:
+			displayName = o.DisplayName.GetOrZero()
+		case beta.OrgContact:
+			displayName = o.DisplayName.GetOrZero()
+		case beta.ServicePrincipal:
+			displayName = o.DisplayName.GetOrZero()
+		case beta.User:
+			displayName = o.DisplayName.GetOrZero()
+		}
+
+		objectId := pointer.From(object.DirectoryObject().Id)
+		objectIds = append(objectIds, objectId)
+		members = append(members, map[string]interface{}{
+			"display_name": displayName,
+			"object_id":    objectId,
+			"type":         memberType,
+		})
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(id.GroupId + "/" + strings.Join(objectIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for object IDs")
+	}
+
+	d.SetId("transitivemembers#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "group_object_id", id.GroupId)
+	tf.Set(d, "members", members)
+	tf.Set(d, "object_ids", objectIds)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type GroupTransitiveMembersDataSource struct{}
+
+func TestAccGroupTransitiveMembersDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_group_transitive_members", "test")
+	r := GroupTransitiveMembersDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("3"),
+				check.That(data.ResourceName).Key("members.#").HasValue("3"),
+			),
+		},
+	})
+}
+
+func TestAccGroupTransitiveMembersDataSource_memberTypes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_group_transitive_members", "test")
+	r := GroupTransitiveMembersDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.memberTypes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("object_ids.0").MatchesOtherKey(check.That("azuread_user.test").Key("object_id")),
+				check.That(data.ResourceName).Key("members.0.type").HasValue("user"),
+				check.That(data.ResourceName).Key("members.0.display_name").HasValue(fmt.Sprintf("acctestUser-%d", data.RandomInteger)),
+			),
+		},
+	})
+}
+
+func (GroupTransitiveMembersDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_group" "inner" {
+  display_name     = "acctestGroup-%[1]d-inner"
+  security_enabled = true
+  members          = [azuread_user.test.object_id, azuread_service_principal.test.object_id]
+}
+
+resource "azuread_group" "outer" {
+  display_name     = "acctestGroup-%[1]d-outer"
+  security_enabled = true
+  members          = [azuread_group.inner.object_id]
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r GroupTransitiveMembersDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_group_transitive_members" "test" {
+  group_object_id = azuread_group.outer.object_id
+}
+`, r.template(data))
+}
+
+func (r GroupTransitiveMembersDataSource) memberTypes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_group_transitive_members" "test" {
+  group_object_id = azuread_group.outer.object_id
+  member_types    = ["user"]
+}
+`, r.template(data))
+}
@@ -7,12 +7,14 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
 	groupBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/group"
 	memberBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/member"
+	ownerBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/owner"
 )
 
 func groupDefaultMailNickname() string {
@@ -96,3 +98,24 @@ func groupGetMember(ctx context.Context, client *memberBeta.MemberClient, id bet
 
 	return nil, nil
 }
+
+func groupGetOwner(ctx context.Context, client *ownerBeta.OwnerClient, id beta.GroupIdOwnerId) (*beta.DirectoryObject, error) {
+	resp, err := client.ListOwners(ctx, beta.NewGroupID(id.GroupId), ownerBeta.DefaultListOwnersOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		} else {
+			return nil, err
+		}
+	}
+
+	if resp.Model != nil {
+		for _, owner := range *resp.Model {
+			if owner.DirectoryObject().Id != nil && strings.EqualFold(*owner.DirectoryObject().Id, id.DirectoryObjectId) {
+				return &owner, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// DirectorySettingId identifies a directory setting, which applies either to the whole tenant (when GroupId is empty)
+// or to a single group
+type DirectorySettingId struct {
+	GroupId   string
+	SettingId string
+}
+
+func NewDirectorySettingID(groupId, settingId string) DirectorySettingId {
+	return DirectorySettingId{
+		GroupId:   groupId,
+		SettingId: settingId,
+	}
+}
+
+func (id DirectorySettingId) String() string {
+	if id.GroupId == "" {
+		return id.SettingId
+	}
+	return NewObjectSubResourceID(id.GroupId, "setting", id.SettingId).String()
+}
+
+func DirectorySettingID(idString string) (*DirectorySettingId, error) {
+	if !strings.Contains(idString, "/") {
+		if _, err := uuid.ParseUUID(idString); err != nil {
+			return nil, fmt.Errorf("unable to parse Directory Setting ID: Setting ID isn't a valid UUID (%q): %+v", idString, err)
+		}
+		return &DirectorySettingId{
+			SettingId: idString,
+		}, nil
+	}
+
+	id, err := ObjectSubResourceID(idString, "setting")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Directory Setting ID: %v", err)
+	}
+
+	return &DirectorySettingId{
+		GroupId:   id.objectId,
+		SettingId: id.subId,
+	}, nil
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "fmt"
+
+type GroupLifecyclePolicyAssignmentId struct {
+	ObjectSubResourceId
+	PolicyId string
+	GroupId  string
+}
+
+func NewGroupLifecyclePolicyAssignmentID(policyId, groupId string) GroupLifecyclePolicyAssignmentId {
+	return GroupLifecyclePolicyAssignmentId{
+		ObjectSubResourceId: NewObjectSubResourceID(policyId, "group", groupId),
+		PolicyId:            policyId,
+		GroupId:             groupId,
+	}
+}
+
+func GroupLifecyclePolicyAssignmentID(idString string) (*GroupLifecyclePolicyAssignmentId, error) {
+	id, err := ObjectSubResourceID(idString, "group")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Group Lifecycle Policy Assignment ID: %v", err)
+	}
+
+	return &GroupLifecyclePolicyAssignmentId{
+		ObjectSubResourceId: *id,
+		PolicyId:            id.objectId,
+		GroupId:             id.subId,
+	}, nil
+}
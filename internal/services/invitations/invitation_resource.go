@@ -25,17 +25,20 @@ import (
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	usersClient "github.com/valiparsa/terraform-provider-azuread/internal/services/users/client"
 )
 
 func invitationResource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		CreateContext: invitationResourceCreate,
 		ReadContext:   invitationResourceRead,
+		UpdateContext: invitationResourceUpdate,
 		DeleteContext: invitationResourceDelete,
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -102,6 +105,18 @@ func invitationResource() *pluginsdk.Resource {
 				},
 			},
 
+			"sponsors": {
+				Description: "A set of object IDs of users or groups who are sponsors of the invited user",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Set:         pluginsdk.HashString,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
 			"user_type": {
 				Description:  "The user type of the user being invited",
 				Type:         pluginsdk.TypeString,
@@ -145,6 +160,17 @@ func invitationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, me
 		properties.InvitedUserMessageInfo = expandInvitedUserMessageInfo(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("sponsors"); ok {
+		sponsors := make([]stable.DirectoryObject, 0)
+		for _, sponsorId := range tf.ExpandStringSlice(v.(*pluginsdk.Set).List()) {
+			sponsors = append(sponsors, stable.BaseDirectoryObjectImpl{
+				Id:                     pointer.To(sponsorId),
+				OmitDiscriminatedValue: true,
+			})
+		}
+		properties.InvitedUserSponsors = &sponsors
+	}
+
 	resp, err := client.CreateInvitation(ctx, properties, invitation.DefaultCreateInvitationOperationOptions())
 	if err != nil {
 		return tf.ErrorDiagF(err, "Creating invitation")
@@ -208,8 +234,37 @@ func invitationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, me
 	return invitationResourceRead(ctx, d, meta)
 }
 
+func invitationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.SponsorClient
+	userId := stable.NewUserID(d.Get("user_id").(string))
+
+	if d.HasChange("sponsors") {
+		oldSponsors, newSponsors := d.GetChange("sponsors")
+		existingSponsors := tf.ExpandStringSlice(oldSponsors.(*pluginsdk.Set).List())
+		desiredSponsors := tf.ExpandStringSlice(newSponsors.(*pluginsdk.Set).List())
+
+		for _, sponsorId := range tf.Difference(existingSponsors, desiredSponsors) {
+			if _, err := client.RemoveSponsorRef(ctx, stable.NewUserIdSponsorID(userId.UserId, sponsorId), usersClient.SponsorOperationOptions{}); err != nil {
+				return tf.ErrorDiagPathF(err, "sponsors", "Could not remove sponsor %q for invited %s", sponsorId, userId)
+			}
+		}
+
+		for _, sponsorId := range tf.Difference(desiredSponsors, existingSponsors) {
+			sponsorRef := stable.ReferenceCreate{
+				ODataId: pointer.To(client.Client.BaseUri + stable.NewDirectoryObjectID(sponsorId).ID()),
+			}
+			if _, err := client.AddSponsorRef(ctx, userId, sponsorRef, usersClient.SponsorOperationOptions{}); err != nil {
+				return tf.ErrorDiagPathF(err, "sponsors", "Could not add sponsor %q for invited %s", sponsorId, userId)
+			}
+		}
+	}
+
+	return invitationResourceRead(ctx, d, meta)
+}
+
 func invitationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Invitations.UserClient
+	sponsorClient := meta.(*clients.Client).Users.SponsorClient
 	userId := stable.NewUserID(d.Get("user_id").(string))
 
 	resp, err := client.GetUser(ctx, userId, user.DefaultGetUserOperationOptions())
@@ -229,6 +284,22 @@ func invitationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta
 	tf.Set(d, "user_id", userId.UserId)
 	tf.Set(d, "user_email_address", resp.Model.Mail.GetOrZero())
 
+	sponsorsResp, err := sponsorClient.ListSponsors(ctx, userId, usersClient.SponsorOperationOptions{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve sponsors for invited %s", userId)
+	}
+
+	sponsors := make([]string, 0)
+	if sponsorsResp.Model != nil {
+		for _, sponsor := range *sponsorsResp.Model {
+			if sponsorId := sponsor.DirectoryObject().Id; sponsorId != nil {
+				sponsors = append(sponsors, *sponsorId)
+			}
+		}
+	}
+
+	tf.Set(d, "sponsors", sponsors)
+
 	return nil
 }
 
@@ -141,6 +141,28 @@ func TestAccInvitation_withGroupMembership(t *testing.T) {
 	})
 }
 
+func TestAccInvitation_sponsors(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_invitation", "test")
+	r := InvitationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withSponsors(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sponsors.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.withSponsorsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sponsors.#").HasValue("1"),
+			),
+		},
+	})
+}
+
 func (r InvitationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Invitations.UserClient
 	userId := stable.NewUserID(state.Attributes["user_id"])
@@ -236,3 +258,46 @@ resource "azuread_group_member" "test" {
 }
 `, data.RandomInteger, data.RandomString, count)
 }
+
+func (InvitationResource) sponsorsTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "sponsor" {
+  user_principal_name = "acctestSponsor.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestSponsor-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "sponsor" {
+  display_name     = "acctestSponsor-%[1]d"
+  security_enabled = true
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r InvitationResource) withSponsors(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_invitation" "test" {
+  redirect_url       = "https://portal.azure.com"
+  user_email_address = "acctest-user-%[2]s@test.com"
+  sponsors           = [azuread_user.sponsor.object_id, azuread_group.sponsor.object_id]
+}
+`, r.sponsorsTemplate(data), data.RandomString)
+}
+
+func (r InvitationResource) withSponsorsUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_invitation" "test" {
+  redirect_url       = "https://portal.azure.com"
+  user_email_address = "acctest-user-%[2]s@test.com"
+  sponsors           = [azuread_group.sponsor.object_id]
+}
+`, r.sponsorsTemplate(data), data.RandomString)
+}
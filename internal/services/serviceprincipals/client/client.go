@@ -19,6 +19,7 @@ type Client struct {
 	ClaimsMappingPolicyClient   *claimsmappingpolicy.ClaimsMappingPolicyClient
 	DirectoryObjectClient       *directoryobject.DirectoryObjectClient
 	OAuth2PermissionGrantClient *oauth2permissiongrant.OAuth2PermissionGrantClient
+	OwnedObjectClient           *OwnedObjectClient
 	ServicePrincipalClient      *serviceprincipal.ServicePrincipalClient
 	ServicePrincipalClientBeta  *serviceprincipalBeta.ServicePrincipalClient
 	ServicePrincipalOwnerClient *owner.OwnerClient
@@ -44,6 +45,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(oAuth2PermissionGrantClient.Client)
 
+	ownedObjectClient, err := NewOwnedObjectClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(ownedObjectClient.Client)
+
 	servicePrincipalClient, err := serviceprincipal.NewServicePrincipalClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -73,6 +80,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ClaimsMappingPolicyClient:   claimsMappingPolicyClient,
 		DirectoryObjectClient:       directoryObjectClient,
 		OAuth2PermissionGrantClient: oAuth2PermissionGrantClient,
+		OwnedObjectClient:           ownedObjectClient,
 		ServicePrincipalClient:      servicePrincipalClient,
 		ServicePrincipalClientBeta:  servicePrincipalClientBeta,
 		ServicePrincipalOwnerClient: servicePrincipalOwnerClient,
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/graphrequest"
+)
+
+// OwnedObjectClient lists the directory objects owned or created by a service principal or user, which are not yet
+// covered by the SDK
+type OwnedObjectClient struct {
+	Client *msgraph.Client
+}
+
+func NewOwnedObjectClientWithBaseURI(api environments.Api) (*OwnedObjectClient, error) {
+	c, err := msgraph.NewClient(api, "ownedobject", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating OwnedObjectClient: %+v", err)
+	}
+
+	return &OwnedObjectClient{
+		Client: c,
+	}, nil
+}
+
+type ListOwnedObjectsOperationOptions struct {
+	RetryFunc client.RequestRetryFunc
+}
+
+type ListOwnedObjectsOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.DirectoryObject
+}
+
+// ListOwnedObjects - List the directory objects owned by a principal. The principal ID should be a
+// stable.ServicePrincipalId or stable.UserId.
+func (c OwnedObjectClient) ListOwnedObjects(ctx context.Context, id resourceids.ResourceId, options ListOwnedObjectsOperationOptions) (ListOwnedObjectsOperationResponse, error) {
+	return c.listDirectoryObjects(ctx, fmt.Sprintf("%s/ownedObjects", id.ID()), options)
+}
+
+// ListCreatedObjects - List the directory objects created by a principal. The principal ID should be a
+// stable.ServicePrincipalId or stable.UserId.
+func (c OwnedObjectClient) ListCreatedObjects(ctx context.Context, id resourceids.ResourceId, options ListOwnedObjectsOperationOptions) (ListOwnedObjectsOperationResponse, error) {
+	return c.listDirectoryObjects(ctx, fmt.Sprintf("%s/createdObjects", id.ID()), options)
+}
+
+func (c OwnedObjectClient) listDirectoryObjects(ctx context.Context, path string, options ListOwnedObjectsOperationOptions) (result ListOwnedObjectsOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodGet, path, nil, true, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValueImplementations(resp, stable.UnmarshalDirectoryObjectImplementation)
+
+	return
+}
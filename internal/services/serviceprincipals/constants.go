@@ -3,6 +3,10 @@
 
 package serviceprincipals
 
+// applicationResourceName is used to lock the application linked to a service principal, when a resource in this
+// package also manages properties of the application
+const applicationResourceName = "azuread_application"
+
 const (
 	DelegatedPermissionGrantConsentTypeAllPrincipals = "AllPrincipals"
 	DelegatedPermissionGrantConsentTypePrincipal     = "Principal"
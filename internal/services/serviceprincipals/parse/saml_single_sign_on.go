@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "fmt"
+
+// SamlSingleSignOnId identifies the SAML single sign-on configuration for a service principal, which spans both the
+// service principal and its linked application
+type SamlSingleSignOnId struct {
+	ObjectSubResourceId
+	ServicePrincipalId string
+	ApplicationId      string
+}
+
+func NewSamlSingleSignOnID(servicePrincipalId, applicationId string) SamlSingleSignOnId {
+	return SamlSingleSignOnId{
+		ObjectSubResourceId: NewObjectSubResourceID(servicePrincipalId, "samlSingleSignOn", applicationId),
+		ServicePrincipalId:  servicePrincipalId,
+		ApplicationId:       applicationId,
+	}
+}
+
+func SamlSingleSignOnID(idString string) (*SamlSingleSignOnId, error) {
+	id, err := ObjectSubResourceID(idString, "samlSingleSignOn")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse SAML Single Sign-On ID: %v", err)
+	}
+
+	return &SamlSingleSignOnId{
+		ObjectSubResourceId: *id,
+		ServicePrincipalId:  id.objectId,
+		ApplicationId:       id.subId,
+	}, nil
+}
@@ -31,8 +31,9 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_service_principal":  servicePrincipalData(),
-		"azuread_service_principals": servicePrincipalsDataSource(),
+		"azuread_service_principal":               servicePrincipalData(),
+		"azuread_service_principal_owned_objects": servicePrincipalOwnedObjectsDataSource(),
+		"azuread_service_principals":              servicePrincipalsDataSource(),
 	}
 }
 
@@ -44,6 +45,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azuread_service_principal_claims_mapping_policy_assignment": servicePrincipalClaimsMappingPolicyAssignmentResource(),
 		"azuread_service_principal_delegated_permission_grant":       servicePrincipalDelegatedPermissionGrantResource(),
 		"azuread_service_principal_password":                         servicePrincipalPasswordResource(),
+		"azuread_service_principal_saml_single_sign_on":              servicePrincipalSamlSingleSignOnResource(),
 		"azuread_service_principal_token_signing_certificate":        servicePrincipalTokenSigningCertificateResource(),
 	}
 }
@@ -52,6 +54,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		ClientConfigDataSource{},
+		ServicePrincipalSamlMetadataDataSource{},
 	}
 }
 
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/serviceprincipals/client"
+)
+
+func servicePrincipalOwnedObjectsDataSource() *pluginsdk.Resource {
+	directoryObjectSchema := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"client_id": {
+				Description: "The client ID of the application or service principal. Empty for other object types",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"display_name": {
+				Description: "The display name of the object",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"object_id": {
+				Description: "The object ID of the object",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"type": {
+				Description: "The type of the object, for example `application`, `group` or `servicePrincipal`",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	return &pluginsdk.Resource{
+		ReadContext: servicePrincipalOwnedObjectsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"object_id": {
+				Description:  "The object ID of the service principal. Defaults to the principal used to authenticate the provider",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"object_types": {
+				Description: "Only return objects of these types. Possible values are `application`, `group` and `servicePrincipal`",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"application", "group", "servicePrincipal"}, false),
+				},
+			},
+
+			"created_objects": {
+				Description: "A list of directory objects created by the principal",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem:        directoryObjectSchema,
+			},
+
+			"owned_objects": {
+				Description: "A list of directory objects owned by the principal",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem:        directoryObjectSchema,
+			},
+		},
+	}
+}
+
+func servicePrincipalOwnedObjectsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	directoryObjectClient := meta.(*clients.Client).ServicePrincipals.DirectoryObjectClient
+	ownedObjectClient := meta.(*clients.Client).ServicePrincipals.OwnedObjectClient
+
+	objectId := d.Get("object_id").(string)
+	if objectId == "" {
+		objectId = meta.(*clients.Client).ObjectID
+	}
+
+	// The calling principal may be either a service principal or a user, so determine which one
+	directoryObjectId := stable.NewDirectoryObjectID(objectId)
+	resp, err := directoryObjectClient.GetDirectoryObject(ctx, directoryObjectId, directoryobject.DefaultGetDirectoryObjectOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "object_id", "Retrieving %s", directoryObjectId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", directoryObjectId)
+	}
+
+	var principalId resourceids.ResourceId
+	switch odataType := servicePrincipalOwnedObjectType(resp.Model); odataType {
+	case "servicePrincipal":
+		principalId = pointer.To(stable.NewServicePrincipalID(objectId))
+	case "user":
+		principalId = pointer.To(stable.NewUserID(objectId))
+	default:
+		return tf.ErrorDiagPathF(nil, "object_id", "%s has unsupported object type %q, expected a service principal or user", directoryObjectId, odataType)
+	}
+
+	objectTypes := tf.ExpandStringSlice(d.Get("object_types").(*pluginsdk.Set).List())
+
+	ownedResp, err := ownedObjectClient.ListOwnedObjects(ctx, principalId, client.ListOwnedObjectsOperationOptions{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing objects owned by %s", principalId)
+	}
+
+	createdResp, err := ownedObjectClient.ListCreatedObjects(ctx, principalId, client.ListOwnedObjectsOperationOptions{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing objects created by %s", principalId)
+	}
+
+	ownedObjects, ownedIds := flattenServicePrincipalOwnedObjects(ownedResp.Model, objectTypes)
+	createdObjects, createdIds := flattenServicePrincipalOwnedObjects(createdResp.Model, objectTypes)
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(objectId + "/" + strings.Join(ownedIds, "/") + "/" + strings.Join(createdIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for object IDs")
+	}
+
+	d.SetId("ownedobjects#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "created_objects", createdObjects)
+	tf.Set(d, "object_id", objectId)
+	tf.Set(d, "owned_objects", ownedObjects)
+
+	return nil
+}
+
+func servicePrincipalOwnedObjectType(in stable.DirectoryObject) string {
+	return strings.TrimPrefix(pointer.From(in.DirectoryObject().ODataType), "#microsoft.graph.")
+}
+
+func flattenServicePrincipalOwnedObjects(in *[]stable.DirectoryObject, objectTypes []string) ([]map[string]interface{}, []string) {
+	result := make([]map[string]interface{}, 0)
+	ids := make([]string, 0)
+	if in == nil {
+		return result, ids
+	}
+
+	for _, object := range *in {
+		objectType := servicePrincipalOwnedObjectType(object)
+
+		if len(objectTypes) > 0 {
+			found := false
+			for _, t := range objectTypes {
+				if strings.EqualFold(t, objectType) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+
+		var clientId, displayName string
+		switch o := object.(type) {
+		case stable.Application:
+			clientId = o.AppId.GetOrZero()
+			displayName = o.DisplayName.GetOrZero()
+		case stable.Group:
+			displayName = o.DisplayName.GetOrZero()
+		case stable.ServicePrincipal:
+			clientId = o.AppId.GetOrZero()
+			displayName = o.DisplayName.GetOrZero()
+		default:
+			// Fall back to the raw values for other object types
+			if raw, ok := object.(stable.RawDirectoryObjectImpl); ok {
+				if v, ok := raw.Values["displayName"].(string); ok {
+					displayName = v
+				}
+			}
+		}
+
+		id := pointer.From(object.DirectoryObject().Id)
+		ids = append(ids, id)
+		result = append(result, map[string]interface{}{
+			"client_id":    clientId,
+			"display_name": displayName,
+			"object_id":    id,
+			"type":         objectType,
+		})
+	}
+
+	return result, ids
+}
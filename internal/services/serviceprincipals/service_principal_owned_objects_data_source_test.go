@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ServicePrincipalOwnedObjectsDataSource struct{}
+
+func TestAccServicePrincipalOwnedObjectsDataSource_byObjectId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal_owned_objects", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ServicePrincipalOwnedObjectsDataSource{}.byObjectId(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("object_id").MatchesOtherKey(check.That("azuread_service_principal.owner").Key("object_id")),
+			check.That(data.ResourceName).Key("owned_objects.#").HasValue("2"),
+			check.That(data.ResourceName).Key("created_objects.#").Exists(),
+		),
+	}})
+}
+
+func TestAccServicePrincipalOwnedObjectsDataSource_callingPrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal_owned_objects", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ServicePrincipalOwnedObjectsDataSource{}.callingPrincipal(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("object_id").IsUuid(),
+			check.That(data.ResourceName).Key("owned_objects.#").Exists(),
+			check.That(data.ResourceName).Key("created_objects.#").Exists(),
+		),
+	}})
+}
+
+func (ServicePrincipalOwnedObjectsDataSource) byObjectId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_client_config" "test" {}
+
+resource "azuread_application" "owner" {
+  display_name = "acctestOwnedObjects-%[1]d-owner"
+}
+
+resource "azuread_service_principal" "owner" {
+  client_id = azuread_application.owner.client_id
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctestOwnedObjects-%[1]d"
+  owners       = [data.azuread_client_config.test.object_id, azuread_service_principal.owner.object_id]
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestOwnedObjects-%[1]d"
+  security_enabled = true
+  owners           = [data.azuread_client_config.test.object_id, azuread_service_principal.owner.object_id]
+}
+
+data "azuread_service_principal_owned_objects" "test" {
+  object_id = azuread_service_principal.owner.object_id
+
+  depends_on = [
+    azuread_application.test,
+    azuread_group.test,
+  ]
+}
+`, data.RandomInteger)
+}
+
+func (ServicePrincipalOwnedObjectsDataSource) callingPrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_client_config" "test" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctestOwnedObjects-%[1]d"
+  owners       = [data.azuread_client_config.test.object_id]
+}
+
+data "azuread_service_principal_owned_objects" "test" {
+  object_types = ["application"]
+
+  depends_on = [azuread_application.test]
+}
+`, data.RandomInteger)
+}
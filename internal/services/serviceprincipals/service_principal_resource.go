@@ -26,6 +26,7 @@ import (
 	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/applications"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/customsecurityattributes"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
@@ -100,6 +101,8 @@ func servicePrincipalResource() *pluginsdk.Resource {
 				Optional:    true,
 			},
 
+			"custom_security_attributes": customsecurityattributes.Schema(),
+
 			"description": {
 				Description:  "Description of the service principal provided for internal end-users",
 				Type:         pluginsdk.TypeString,
@@ -403,6 +406,11 @@ func servicePrincipalResourceCreate(ctx context.Context, d *pluginsdk.ResourceDa
 		return tf.ImportAsExistsDiag("azuread_service_principal", *servicePrincipal.Id)
 	}
 
+	customSecurityAttributes, err := customsecurityattributes.Expand(d.Get("custom_security_attributes").(*pluginsdk.Set).List(), nil)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "custom_security_attributes", "Could not expand custom security attributes")
+	}
+
 	var tags []string
 	if v, ok := d.GetOk("feature_tags"); ok {
 		tags = applications.ExpandFeatures(v.([]interface{}))
@@ -525,6 +533,12 @@ func servicePrincipalResourceCreate(ctx context.Context, d *pluginsdk.ResourceDa
 		}
 	}
 
+	if len(customSecurityAttributes) > 0 {
+		if _, err = customsecurityattributes.Update(ctx, client.Client, &id, customSecurityAttributes); err != nil {
+			return tf.ErrorDiagPathF(err, "custom_security_attributes", "Could not assign custom security attributes for %s", id)
+		}
+	}
+
 	return servicePrincipalResourceRead(ctx, d, meta)
 }
 
@@ -596,6 +610,17 @@ func servicePrincipalResourceUpdate(ctx context.Context, d *pluginsdk.ResourceDa
 		}
 	}
 
+	if d.HasChange("custom_security_attributes") {
+		oldAttributes, newAttributes := d.GetChange("custom_security_attributes")
+		customSecurityAttributes, err := customsecurityattributes.Expand(newAttributes.(*pluginsdk.Set).List(), oldAttributes.(*pluginsdk.Set).List())
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "custom_security_attributes", "Could not expand custom security attributes")
+		}
+		if _, err = customsecurityattributes.Update(ctx, client.Client, id, customSecurityAttributes); err != nil {
+			return tf.ErrorDiagPathF(err, "custom_security_attributes", "Could not assign custom security attributes for %s", id)
+		}
+	}
+
 	return servicePrincipalResourceRead(ctx, d, meta)
 }
 
@@ -687,6 +712,15 @@ func servicePrincipalResourceRead(ctx context.Context, d *pluginsdk.ResourceData
 	}
 	tf.Set(d, "owners", owners)
 
+	// Custom security attributes require additional permissions to read, so they are only retrieved when managed
+	if len(d.Get("custom_security_attributes").(*pluginsdk.Set).List()) > 0 {
+		_, customSecurityAttributes, err := customsecurityattributes.Get(ctx, client.Client, id)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve custom security attributes for %s", id)
+		}
+		tf.Set(d, "custom_security_attributes", customsecurityattributes.Flatten(customSecurityAttributes, d.Get("custom_security_attributes").(*pluginsdk.Set).List()))
+	}
+
 	return nil
 }
 
@@ -324,6 +324,35 @@ func TestAccServicePrincipal_fromApplicationTemplate(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipal_customSecurityAttributes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customSecurityAttributes(data, "Alpine"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_security_attributes.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.customSecurityAttributes(data, "Baker"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_security_attributes.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_security_attributes.#").HasValue("0"),
+			),
+		},
+	})
+}
+
 func (r ServicePrincipalResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.ServicePrincipals.ServicePrincipalClient
 
@@ -357,6 +386,39 @@ resource "azuread_service_principal" "test" {
 `, data.RandomInteger)
 }
 
+func (ServicePrincipalResource) customSecurityAttributes(data acceptance.TestData, project string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_attribute_set" "test" {
+  name = "acctestSP%[2]s"
+}
+
+resource "azuread_custom_security_attribute_definition" "test" {
+  attribute_set          = azuread_attribute_set.test.name
+  name                   = "Project"
+  type                   = "String"
+  predefined_values_only = true
+  allowed_values         = ["Alpine", "Baker"]
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+
+  custom_security_attributes {
+    attribute_set = azuread_attribute_set.test.name
+    name          = azuread_custom_security_attribute_definition.test.name
+    type          = "String"
+    value         = "%[3]s"
+  }
+}
+`, data.RandomInteger, data.RandomString, project)
+}
+
 func (ServicePrincipalResource) templateComplete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
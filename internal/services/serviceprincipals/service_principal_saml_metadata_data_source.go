@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/saml"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/valiparsa/terraform-provider-azuread/internal/sdk"
+)
+
+type ServicePrincipalSamlMetadataId struct {
+	TenantId string
+	ClientId string
+}
+
+func (id ServicePrincipalSamlMetadataId) ID() string {
+	return fmt.Sprintf("samlMetadata-%s-%s", id.TenantId, id.ClientId)
+}
+
+func (id ServicePrincipalSamlMetadataId) String() string {
+	return fmt.Sprintf("SAML Metadata (Tenant ID: %q, Client ID: %q)", id.TenantId, id.ClientId)
+}
+
+type ServicePrincipalSamlMetadataDataSourceModel struct {
+	ClientId            string                    `tfschema:"client_id"`
+	ServicePrincipalId  string                    `tfschema:"service_principal_id"`
+	MetadataUrl         string                    `tfschema:"metadata_url"`
+	MetadataXml         string                    `tfschema:"metadata_xml"`
+	EntityId            string                    `tfschema:"entity_id"`
+	SingleSignOnService []SamlMetadataEndpoint    `tfschema:"single_sign_on_service"`
+	SingleLogoutService []SamlMetadataEndpoint    `tfschema:"single_logout_service"`
+	SigningCertificates []SamlMetadataCertificate `tfschema:"signing_certificate"`
+}
+
+type SamlMetadataEndpoint struct {
+	Binding  string `tfschema:"binding"`
+	Location string `tfschema:"location"`
+}
+
+type SamlMetadataCertificate struct {
+	Value      string `tfschema:"value"`
+	Thumbprint string `tfschema:"thumbprint"`
+	Subject    string `tfschema:"subject"`
+	StartDate  string `tfschema:"start_date"`
+	EndDate    string `tfschema:"end_date"`
+}
+
+type ServicePrincipalSamlMetadataDataSource struct{}
+
+var _ sdk.DataSource = ServicePrincipalSamlMetadataDataSource{}
+
+func (r ServicePrincipalSamlMetadataDataSource) ResourceType() string {
+	return "azuread_service_principal_saml_metadata"
+}
+
+func (r ServicePrincipalSamlMetadataDataSource) ModelObject() interface{} {
+	return &ServicePrincipalSamlMetadataDataSourceModel{}
+}
+
+func (r ServicePrincipalSamlMetadataDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"client_id": {
+			Description:  "The client ID of the application for which to retrieve federation metadata",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"client_id", "service_principal_id"},
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"service_principal_id": {
+			Description:  "The ID of the service principal for which to retrieve federation metadata",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"client_id", "service_principal_id"},
+			ValidateFunc: stable.ValidateServicePrincipalID,
+		},
+	}
+}
+
+func samlMetadataEndpointSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"binding": {
+					Description: "The SAML binding supported by this endpoint",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+
+				"location": {
+					Description: "The URL of this endpoint",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func (r ServicePrincipalSamlMetadataDataSource) Attributes() map[string]*pluginsdk.Schema {
+	singleSignOnService := samlMetadataEndpointSchema()
+	singleSignOnService.Description = "The single sign-on endpoints of the identity provider"
+
+	singleLogoutService := samlMetadataEndpointSchema()
+	singleLogoutService.Description = "The single logout endpoints of the identity provider"
+
+	return map[string]*pluginsdk.Schema{
+		"metadata_url": {
+			Description: "The URL of the federation metadata document",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"metadata_xml": {
+			Description: "The raw federation metadata document",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"entity_id": {
+			Description: "The entity ID (issuer) of the identity provider",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"single_sign_on_service": singleSignOnService,
+
+		"single_logout_service": singleLogoutService,
+
+		"signing_certificate": {
+			Description: "The token signing certificates published in the federation metadata",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"value": {
+						Description: "The base64-encoded DER certificate",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"thumbprint": {
+						Description: "The SHA-1 thumbprint of the certificate",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"subject": {
+						Description: "The subject of the certificate",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"start_date": {
+						Description: "The start date from which the certificate is valid, formatted as an RFC3339 date string",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"end_date": {
+						Description: "The end date until which the certificate is valid, formatted as an RFC3339 date string",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ServicePrincipalSamlMetadataDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServicePrincipals.ServicePrincipalClient
+
+			var model ServicePrincipalSamlMetadataDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clientId := model.ClientId
+			if model.ServicePrincipalId != "" {
+				servicePrincipalId, err := stable.ParseServicePrincipalID(model.ServicePrincipalId)
+				if err != nil {
+					return err
+				}
+
+				resp, err := client.GetServicePrincipal(ctx, *servicePrincipalId, serviceprincipal.GetServicePrincipalOperationOptions{
+					Select: pointer.To([]string{"appId"}),
+				})
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return fmt.Errorf("%s was not found", servicePrincipalId)
+					}
+					return fmt.Errorf("retrieving %s: %+v", servicePrincipalId, err)
+				}
+				if resp.Model == nil {
+					return fmt.Errorf("retrieving %s: model was nil", servicePrincipalId)
+				}
+
+				clientId = resp.Model.AppId.GetOrZero()
+			}
+
+			id := ServicePrincipalSamlMetadataId{
+				TenantId: metadata.Client.TenantID,
+				ClientId: clientId,
+			}
+
+			if metadata.Client.Environment.Authorization == nil {
+				return fmt.Errorf("building federation metadata URL for %s: login endpoint was not found for the configured environment", id)
+			}
+			metadataUrl := saml.FederationMetadataUrl(metadata.Client.Environment.Authorization.LoginEndpoint, id.TenantId, id.ClientId)
+
+			raw, err := saml.FetchMetadata(ctx, metadataUrl, metadata.Client.UserAgent)
+			if err != nil {
+				return fmt.Errorf("retrieving federation metadata for %s: %+v", id, err)
+			}
+
+			result, err := saml.ParseMetadata(raw)
+			if err != nil {
+				return fmt.Errorf("parsing federation metadata for %s: %+v", id, err)
+			}
+
+			state := ServicePrincipalSamlMetadataDataSourceModel{
+				ClientId:            clientId,
+				ServicePrincipalId:  model.ServicePrincipalId,
+				MetadataUrl:         metadataUrl,
+				MetadataXml:         string(raw),
+				EntityId:            result.EntityId,
+				SingleSignOnService: flattenSamlMetadataEndpoints(result.SingleSignOnService),
+				SingleLogoutService: flattenSamlMetadataEndpoints(result.SingleLogoutService),
+				SigningCertificates: make([]SamlMetadataCertificate, 0, len(result.SigningCertificates)),
+			}
+
+			for _, cert := range result.SigningCertificates {
+				state.SigningCertificates = append(state.SigningCertificates, SamlMetadataCertificate{
+					Value:      cert.Value,
+					Thumbprint: cert.Thumbprint,
+					Subject:    cert.Subject,
+					StartDate:  cert.NotBefore.Format(time.RFC3339),
+					EndDate:    cert.NotAfter.Format(time.RFC3339),
+				})
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenSamlMetadataEndpoints(in []saml.Endpoint) []SamlMetadataEndpoint {
+	result := make([]SamlMetadataEndpoint, 0, len(in))
+	for _, e := range in {
+		result = append(result, SamlMetadataEndpoint{
+			Binding:  e.Binding,
+			Location: e.Location,
+		})
+	}
+	return result
+}
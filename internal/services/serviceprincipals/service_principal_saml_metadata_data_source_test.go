@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ServicePrincipalSamlMetadataDataSource struct{}
+
+func TestAccServicePrincipalSamlMetadataDataSource_byServicePrincipalId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal_saml_metadata", "test")
+	r := ServicePrincipalSamlMetadataDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byServicePrincipalId(data),
+			Check:  r.testCheckFunc(data),
+		},
+	})
+}
+
+func TestAccServicePrincipalSamlMetadataDataSource_byClientId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal_saml_metadata", "test")
+	r := ServicePrincipalSamlMetadataDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byClientId(data),
+			Check:  r.testCheckFunc(data),
+		},
+	})
+}
+
+func (ServicePrincipalSamlMetadataDataSource) testCheckFunc(data acceptance.TestData) acceptance.TestCheckFunc {
+	return acceptance.ComposeTestCheckFunc(
+		check.That(data.ResourceName).Key("client_id").IsUuid(),
+		check.That(data.ResourceName).Key("entity_id").Exists(),
+		check.That(data.ResourceName).Key("metadata_url").Exists(),
+		check.That(data.ResourceName).Key("metadata_xml").Exists(),
+		check.That(data.ResourceName).Key("single_sign_on_service.#").Exists(),
+		check.That(data.ResourceName).Key("signing_certificate.0.thumbprint").Exists(),
+		check.That(data.ResourceName).Key("signing_certificate.0.end_date").Exists(),
+	)
+}
+
+func (ServicePrincipalSamlMetadataDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application_registration" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application_registration.test.client_id
+}
+
+resource "azuread_service_principal_token_signing_certificate" "test" {
+  service_principal_id = azuread_service_principal.test.id
+}
+
+resource "azuread_service_principal_saml_single_sign_on" "test" {
+  service_principal_id                   = azuread_service_principal.test.id
+  identifier_uris                        = ["api://acctest-saml-%[1]d"]
+  reply_urls                             = ["https://acctest-saml-%[1]d.example.com/saml/acs"]
+  preferred_token_signing_key_thumbprint = azuread_service_principal_token_signing_certificate.test.thumbprint
+}
+`, data.RandomInteger)
+}
+
+func (r ServicePrincipalSamlMetadataDataSource) byServicePrincipalId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principal_saml_metadata" "test" {
+  service_principal_id = azuread_service_principal_saml_single_sign_on.test.service_principal_id
+}
+`, r.template(data))
+}
+
+func (r ServicePrincipalSamlMetadataDataSource) byClientId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principal_saml_metadata" "test" {
+  client_id = azuread_service_principal.test.client_id
+
+  depends_on = [azuread_service_principal_saml_single_sign_on.test]
+}
+`, r.template(data))
+}
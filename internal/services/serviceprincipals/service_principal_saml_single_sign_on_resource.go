@@ -0,0 +1,465 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/serviceprincipals/parse"
+)
+
+func servicePrincipalSamlSingleSignOnResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: servicePrincipalSamlSingleSignOnResourceCreate,
+		ReadContext:   servicePrincipalSamlSingleSignOnResourceRead,
+		UpdateContext: servicePrincipalSamlSingleSignOnResourceUpdate,
+		DeleteContext: servicePrincipalSamlSingleSignOnResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SamlSingleSignOnID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_id": {
+				Description:  "The ID of the service principal for which SAML single sign-on should be configured",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: stable.ValidateServicePrincipalID,
+			},
+
+			"identifier_uris": {
+				Description: "The identifiers (entity IDs) that uniquely identify the application to Azure AD, set on the linked application",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"reply_urls": {
+				Description: "The reply URLs (assertion consumer service URLs) where the application expects to receive the SAML token, set on the linked application",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"login_url": {
+				Description:  "The sign-on URL, used to perform service provider initiated single sign-on",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsHttpOrHttpsUrl,
+			},
+
+			"logout_url": {
+				Description:  "The URL that will be used by Microsoft's authorization service to sign out a user using SAML logout protocols, set on the linked application",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsLogoutUrl,
+			},
+
+			"notification_email_addresses": {
+				Description: "Email addresses where Azure AD sends a notification when the active signing certificate is near its expiration date",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"preferred_token_signing_key_thumbprint": {
+				Description:  "The thumbprint of the active token signing certificate, for example from the `azuread_service_principal_token_signing_certificate` resource",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"relay_state": {
+				Description:  "The relative URI the service provider would redirect to after completion of the single sign-on flow",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_object_id": {
+				Description: "The object ID of the application linked to the service principal",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func servicePrincipalSamlSingleSignOnResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+	applicationClient := meta.(*clients.Client).Applications.ApplicationClient
+
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Get("service_principal_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Parsing `service_principal_id`")
+	}
+
+	resp, err := client.GetServicePrincipal(ctx, *servicePrincipalId, serviceprincipal.GetServicePrincipalOperationOptions{
+		Select: pointer.To([]string{"appId"}),
+	})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "service_principal_id", "%s was not found", servicePrincipalId)
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving %s", servicePrincipalId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", servicePrincipalId)
+	}
+
+	appId := resp.Model.AppId.GetOrZero()
+	listResp, err := applicationClient.ListApplications(ctx, application.ListApplicationsOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(appId))),
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving application with client ID %q for %s", appId, servicePrincipalId)
+	}
+
+	var applicationObjectId string
+	if listResp.Model != nil {
+		for _, app := range *listResp.Model {
+			if strings.EqualFold(app.AppId.GetOrZero(), appId) {
+				applicationObjectId = pointer.From(app.Id)
+				break
+			}
+		}
+	}
+	if applicationObjectId == "" {
+		return tf.ErrorDiagPathF(fmt.Errorf("no application was found with client ID %q, the linked application must exist in this tenant in order to configure SAML single sign-on", appId), "service_principal_id", "Retrieving application for %s", servicePrincipalId)
+	}
+
+	id := parse.NewSamlSingleSignOnID(servicePrincipalId.ServicePrincipalId, applicationObjectId)
+
+	if diags := servicePrincipalSamlSingleSignOnApply(ctx, d, meta, id); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+
+	return servicePrincipalSamlSingleSignOnResourceRead(ctx, d, meta)
+}
+
+func servicePrincipalSamlSingleSignOnResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	id, err := parse.SamlSingleSignOnID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing SAML single sign-on with ID %q", d.Id())
+	}
+
+	if diags := servicePrincipalSamlSingleSignOnApply(ctx, d, meta, *id); diags.HasError() {
+		return diags
+	}
+
+	return servicePrincipalSamlSingleSignOnResourceRead(ctx, d, meta)
+}
+
+// servicePrincipalSamlSingleSignOnApply updates both the application and the service principal, since the SAML
+// configuration for an enterprise application is split between them
+func servicePrincipalSamlSingleSignOnApply(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id parse.SamlSingleSignOnId) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+	applicationClient := meta.(*clients.Client).Applications.ApplicationClient
+
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+	applicationId := stable.NewApplicationID(id.ApplicationId)
+
+	tf.LockByName(applicationResourceName, id.ApplicationId)
+	defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+	resp, err := applicationClient.GetApplication(ctx, applicationId, application.GetApplicationOperationOptions{
+		Select: pointer.To([]string{"identifierUris", "web"}),
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving %s", applicationId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", applicationId)
+	}
+
+	// The web property is replaced in its entirety, so retain any settings and URLs not managed by this resource
+	web := stable.WebApplication{}
+	if resp.Model.Web != nil {
+		web = *resp.Model.Web
+	}
+	web.RedirectUriSettings = nil
+
+	oldReplyUrls, newReplyUrls := d.GetChange("reply_urls")
+	web.RedirectUris = pointer.To(samlSingleSignOnReplaceValues(
+		pointer.From(web.RedirectUris),
+		tf.ExpandStringSlice(oldReplyUrls.([]interface{})),
+		tf.ExpandStringSlice(newReplyUrls.([]interface{})),
+	))
+
+	oldLogoutUrl, newLogoutUrl := d.GetChange("logout_url")
+	if newLogoutUrl.(string) != "" {
+		web.LogoutUrl = nullable.Value(newLogoutUrl.(string))
+	} else if oldLogoutUrl.(string) != "" && web.LogoutUrl.GetOrZero() == oldLogoutUrl.(string) {
+		web.LogoutUrl.SetNull()
+	}
+
+	oldIdentifierUris, newIdentifierUris := d.GetChange("identifier_uris")
+	applicationProperties := stable.Application{
+		IdentifierUris: pointer.To(samlSingleSignOnReplaceValues(
+			pointer.From(resp.Model.IdentifierUris),
+			tf.ExpandStringSlice(oldIdentifierUris.([]interface{})),
+			tf.ExpandStringSlice(newIdentifierUris.([]interface{})),
+		)),
+		Web: &web,
+	}
+
+	if _, err = applicationClient.UpdateApplication(ctx, applicationId, applicationProperties, application.DefaultUpdateApplicationOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating SAML URLs for %s", applicationId)
+	}
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	servicePrincipalProperties := stable.ServicePrincipal{
+		LoginUrl:                           nullable.NoZero(d.Get("login_url").(string)),
+		NotificationEmailAddresses:         tf.ExpandStringSlicePtr(d.Get("notification_email_addresses").(*pluginsdk.Set).List()),
+		PreferredSingleSignOnMode:          nullable.Value(PreferredSingleSignOnModeSaml),
+		PreferredTokenSigningKeyThumbprint: nullable.NoZero(d.Get("preferred_token_signing_key_thumbprint").(string)),
+		SamlSingleSignOnSettings: &stable.SamlSingleSignOnSettings{
+			RelayState: nullable.NoZero(d.Get("relay_state").(string)),
+		},
+	}
+
+	if d.HasChange("login_url") && d.Get("login_url").(string) == "" {
+		servicePrincipalProperties.LoginUrl.SetNull()
+	}
+	if d.HasChange("relay_state") && d.Get("relay_state").(string) == "" {
+		servicePrincipalProperties.SamlSingleSignOnSettings.RelayState.SetNull()
+	}
+
+	if _, err = client.UpdateServicePrincipal(ctx, servicePrincipalId, servicePrincipalProperties, serviceprincipal.DefaultUpdateServicePrincipalOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Configuring SAML single sign-on for %s", servicePrincipalId)
+	}
+
+	return nil
+}
+
+func servicePrincipalSamlSingleSignOnResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+	applicationClient := meta.(*clients.Client).Applications.ApplicationClient
+
+	id, err := parse.SamlSingleSignOnID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing SAML single sign-on with ID %q", d.Id())
+	}
+
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+	applicationId := stable.NewApplicationID(id.ApplicationId)
+
+	resp, err := client.GetServicePrincipal(ctx, servicePrincipalId, serviceprincipal.GetServicePrincipalOperationOptions{
+		Select: pointer.To([]string{
+			"loginUrl",
+			"notificationEmailAddresses",
+			"preferredSingleSignOnMode",
+			"preferredTokenSigningKeyThumbprint",
+			"samlSingleSignOnSettings",
+		}),
+	})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", servicePrincipalId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving %s", servicePrincipalId)
+	}
+
+	servicePrincipal := resp.Model
+	if servicePrincipal == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", servicePrincipalId)
+	}
+
+	if servicePrincipal.PreferredSingleSignOnMode.GetOrZero() != PreferredSingleSignOnModeSaml {
+		log.Printf("[DEBUG] SAML single sign-on is not enabled for %s - removing from state!", servicePrincipalId)
+		d.SetId("")
+		return nil
+	}
+
+	applicationResp, err := applicationClient.GetApplication(ctx, applicationId, application.GetApplicationOperationOptions{
+		Select: pointer.To([]string{"identifierUris", "web"}),
+	})
+	if err != nil {
+		if response.WasNotFound(applicationResp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", applicationId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", applicationId)
+	}
+
+	app := applicationResp.Model
+	if app == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", applicationId)
+	}
+
+	tf.Set(d, "service_principal_id", servicePrincipalId.ID())
+	tf.Set(d, "application_object_id", id.ApplicationId)
+	tf.Set(d, "login_url", servicePrincipal.LoginUrl.GetOrZero())
+	tf.Set(d, "notification_email_addresses", tf.FlattenStringSlicePtr(servicePrincipal.NotificationEmailAddresses))
+	tf.Set(d, "preferred_token_signing_key_thumbprint", servicePrincipal.PreferredTokenSigningKeyThumbprint.GetOrZero())
+
+	relayState := ""
+	if servicePrincipal.SamlSingleSignOnSettings != nil {
+		relayState = servicePrincipal.SamlSingleSignOnSettings.RelayState.GetOrZero()
+	}
+	tf.Set(d, "relay_state", relayState)
+
+	logoutUrl := ""
+	replyUrls := make([]string, 0)
+	if app.Web != nil {
+		logoutUrl = app.Web.LogoutUrl.GetOrZero()
+		replyUrls = pointer.From(app.Web.RedirectUris)
+	}
+
+	// Other resources may also add identifier URIs and reply URLs to the application, so only those managed here are
+	// tracked, unless nothing is yet managed (i.e. on import)
+	identifierUris := pointer.From(app.IdentifierUris)
+	if managed := tf.ExpandStringSlice(d.Get("identifier_uris").([]interface{})); len(managed) > 0 {
+		identifierUris = samlSingleSignOnManagedValues(identifierUris, managed)
+	}
+	if managed := tf.ExpandStringSlice(d.Get("reply_urls").([]interface{})); len(managed) > 0 {
+		replyUrls = samlSingleSignOnManagedValues(replyUrls, managed)
+	}
+
+	tf.Set(d, "identifier_uris", identifierUris)
+	tf.Set(d, "logout_url", logoutUrl)
+	tf.Set(d, "reply_urls", replyUrls)
+
+	return nil
+}
+
+func servicePrincipalSamlSingleSignOnResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+	applicationClient := meta.(*clients.Client).Applications.ApplicationClient
+
+	id, err := parse.SamlSingleSignOnID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing SAML single sign-on with ID %q", d.Id())
+	}
+
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+	applicationId := stable.NewApplicationID(id.ApplicationId)
+
+	// Lock the application before the service principal, in the same order as when creating or updating
+	tf.LockByName(applicationResourceName, id.ApplicationId)
+	defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	servicePrincipalProperties := stable.ServicePrincipal{
+		NotificationEmailAddresses: &[]string{},
+		SamlSingleSignOnSettings:   &stable.SamlSingleSignOnSettings{},
+	}
+	servicePrincipalProperties.LoginUrl.SetNull()
+	servicePrincipalProperties.PreferredSingleSignOnMode.SetNull()
+	servicePrincipalProperties.SamlSingleSignOnSettings.RelayState.SetNull()
+
+	if resp, err := client.UpdateServicePrincipal(ctx, servicePrincipalId, servicePrincipalProperties, serviceprincipal.DefaultUpdateServicePrincipalOperationOptions()); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Disabling SAML single sign-on for %s", servicePrincipalId)
+		}
+	}
+
+	resp, err := applicationClient.GetApplication(ctx, applicationId, application.GetApplicationOperationOptions{
+		Select: pointer.To([]string{"identifierUris", "web"}),
+	})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", applicationId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", applicationId)
+	}
+
+	// Only remove the URLs managed by this resource, since other resources may also have added URLs
+	web := stable.WebApplication{}
+	if resp.Model.Web != nil {
+		web = *resp.Model.Web
+	}
+	web.RedirectUriSettings = nil
+	web.RedirectUris = pointer.To(samlSingleSignOnReplaceValues(pointer.From(web.RedirectUris), tf.ExpandStringSlice(d.Get("reply_urls").([]interface{})), nil))
+	if logoutUrl := d.Get("logout_url").(string); logoutUrl != "" && web.LogoutUrl.GetOrZero() == logoutUrl {
+		web.LogoutUrl.SetNull()
+	}
+
+	applicationProperties := stable.Application{
+		IdentifierUris: pointer.To(samlSingleSignOnReplaceValues(pointer.From(resp.Model.IdentifierUris), tf.ExpandStringSlice(d.Get("identifier_uris").([]interface{})), nil)),
+		Web:            &web,
+	}
+
+	if _, err = applicationClient.UpdateApplication(ctx, applicationId, applicationProperties, application.DefaultUpdateApplicationOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Removing SAML URLs for %s", applicationId)
+	}
+
+	return nil
+}
+
+// samlSingleSignOnReplaceValues removes the previously managed values from the existing values, then appends the
+// desired values, retaining any values not managed by this resource
+func samlSingleSignOnReplaceValues(existing, previous, desired []string) []string {
+	result := make([]string, 0)
+	for _, v := range tf.Difference(existing, previous) {
+		if !slices.Contains(desired, v) {
+			result = append(result, v)
+		}
+	}
+
+	return append(result, desired...)
+}
+
+// samlSingleSignOnManagedValues returns the managed values which are present in the existing values, in the order
+// they are managed
+func samlSingleSignOnManagedValues(existing, managed []string) []string {
+	result := make([]string, 0)
+	for _, v := range managed {
+		if slices.Contains(existing, v) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
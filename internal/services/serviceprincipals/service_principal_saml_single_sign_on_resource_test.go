@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/serviceprincipals/parse"
+)
+
+type servicePrincipalSamlSingleSignOnResource struct{}
+
+func TestAccServicePrincipalSamlSingleSignOn_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_saml_single_sign_on", "test")
+	r := servicePrincipalSamlSingleSignOnResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("application_object_id").Exists(),
+				check.That(data.ResourceName).Key("identifier_uris.#").HasValue("1"),
+				check.That(data.ResourceName).Key("reply_urls.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipalSamlSingleSignOn_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_saml_single_sign_on", "test")
+	r := servicePrincipalSamlSingleSignOnResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identifier_uris.#").HasValue("2"),
+				check.That(data.ResourceName).Key("reply_urls.#").HasValue("2"),
+				check.That(data.ResourceName).Key("notification_email_addresses.#").HasValue("1"),
+				check.That(data.ResourceName).Key("preferred_token_signing_key_thumbprint").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipalSamlSingleSignOn_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_saml_single_sign_on", "test")
+	r := servicePrincipalSamlSingleSignOnResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipalSamlSingleSignOn_existingUrls(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_saml_single_sign_on", "test")
+	r := servicePrincipalSamlSingleSignOnResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.existingUrls(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identifier_uris.#").HasValue("1"),
+				check.That("azuread_application_identifier_uri.test").ExistsInAzure(r),
+			),
+		},
+		{
+			// Removing SAML single sign-on should leave the identifier URI managed elsewhere in place, which would
+			// otherwise be detected as a non-empty plan
+			Config: r.existingUrlsRemoved(data),
+		},
+	})
+}
+
+func (r servicePrincipalSamlSingleSignOnResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.ServicePrincipals.ServicePrincipalClient
+
+	id, err := parse.SamlSingleSignOnID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing SAML Single Sign-On ID: %v", err)
+	}
+
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+
+	resp, err := client.GetServicePrincipal(ctx, servicePrincipalId, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, fmt.Errorf("%s does not exist", servicePrincipalId)
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", servicePrincipalId, err)
+	}
+
+	servicePrincipal := resp.Model
+	if servicePrincipal == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", servicePrincipalId)
+	}
+
+	return pointer.To(servicePrincipal.PreferredSingleSignOnMode.GetOrZero() == "saml"), nil
+}
+
+func (servicePrincipalSamlSingleSignOnResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application_registration" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application_registration.test.client_id
+}
+`, data.RandomInteger)
+}
+
+func (r servicePrincipalSamlSingleSignOnResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_saml_single_sign_on" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  identifier_uris      = ["api://acctest-saml-%[2]d"]
+  reply_urls           = ["https://acctest-saml-%[2]d.example.com/saml/acs"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r servicePrincipalSamlSingleSignOnResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_token_signing_certificate" "test" {
+  service_principal_id = azuread_service_principal.test.id
+}
+
+resource "azuread_claims_mapping_policy" "test" {
+  definition = [
+    jsonencode({
+      ClaimsMappingPolicy = {
+        Version              = 1
+        IncludeBasicClaimSet = "true"
+        ClaimsSchema = [
+          {
+            Source        = "user"
+            ID            = "employeeid"
+            SamlClaimType = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/employeeid"
+          },
+        ]
+      }
+    })
+  ]
+  display_name = "acctest-saml-%[2]d"
+}
+
+resource "azuread_service_principal_claims_mapping_policy_assignment" "test" {
+  claims_mapping_policy_id = azuread_claims_mapping_policy.test.id
+  service_principal_id     = azuread_service_principal.test.id
+}
+
+resource "azuread_service_principal_saml_single_sign_on" "test" {
+  service_principal_id = azuread_service_principal.test.id
+
+  identifier_uris = [
+    "api://acctest-saml-%[2]d",
+    "api://acctest-saml-%[2]d/secondary",
+  ]
+
+  reply_urls = [
+    "https://acctest-saml-%[2]d.example.com/saml/acs",
+    "https://acctest-saml-%[2]d.example.com/saml/acs/secondary",
+  ]
+
+  login_url                              = "https://acctest-saml-%[2]d.example.com/login"
+  logout_url                             = "https://acctest-saml-%[2]d.example.com/logout"
+  notification_email_addresses           = ["acctest-saml-%[2]d@example.com"]
+  preferred_token_signing_key_thumbprint = azuread_service_principal_token_signing_certificate.test.thumbprint
+  relay_state                            = "/dashboard"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r servicePrincipalSamlSingleSignOnResource) existingUrlsRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_identifier_uri" "test" {
+  application_id = azuread_application_registration.test.id
+  identifier_uri = "api://acctest-existing-%[2]d"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r servicePrincipalSamlSingleSignOnResource) existingUrls(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_saml_single_sign_on" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  identifier_uris      = ["api://acctest-saml-%[2]d"]
+  reply_urls           = ["https://acctest-saml-%[2]d.example.com/saml/acs"]
+
+  depends_on = [azuread_application_identifier_uri.test]
+}
+`, r.existingUrlsRemoved(data), data.RandomInteger)
+}
@@ -12,9 +12,11 @@ import (
 )
 
 type Client struct {
-	ServicePrincipalClient      *serviceprincipal.ServicePrincipalClient
-	SynchronizationJobClient    *synchronizationjob.SynchronizationJobClient
-	SynchronizationSecretClient *synchronizationsecret.SynchronizationSecretClient
+	ServicePrincipalClient        *serviceprincipal.ServicePrincipalClient
+	SynchronizationJobClient      *synchronizationjob.SynchronizationJobClient
+	SynchronizationSchemaClient   *SynchronizationSchemaClient
+	SynchronizationSecretClient   *synchronizationsecret.SynchronizationSecretClient
+	SynchronizationTemplateClient *SynchronizationTemplateClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -30,15 +32,29 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(synchronizationJobClient.Client)
 
+	synchronizationSchemaClient, err := NewSynchronizationSchemaClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(synchronizationSchemaClient.Client)
+
 	synchronizationSecretClient, err := synchronizationsecret.NewSynchronizationSecretClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
 	}
 	o.Configure(synchronizationSecretClient.Client)
 
+	synchronizationTemplateClient, err := NewSynchronizationTemplateClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(synchronizationTemplateClient.Client)
+
 	return &Client{
-		ServicePrincipalClient:      servicePrincipalClient,
-		SynchronizationJobClient:    synchronizationJobClient,
-		SynchronizationSecretClient: synchronizationSecretClient,
+		ServicePrincipalClient:        servicePrincipalClient,
+		SynchronizationJobClient:      synchronizationJobClient,
+		SynchronizationSchemaClient:   synchronizationSchemaClient,
+		SynchronizationSecretClient:   synchronizationSecretClient,
+		SynchronizationTemplateClient: synchronizationTemplateClient,
 	}, nil
 }
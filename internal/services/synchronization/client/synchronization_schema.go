@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/graphrequest"
+)
+
+// SynchronizationSchemaClient manages the schema of a synchronization job, which is not yet covered by the SDK
+type SynchronizationSchemaClient struct {
+	Client *msgraph.Client
+}
+
+func NewSynchronizationSchemaClientWithBaseURI(api environments.Api) (*SynchronizationSchemaClient, error) {
+	c, err := msgraph.NewClient(api, "synchronizationschema", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating SynchronizationSchemaClient: %+v", err)
+	}
+
+	return &SynchronizationSchemaClient{
+		Client: c,
+	}, nil
+}
+
+type SynchronizationSchemaOperationOptions struct {
+	RetryFunc client.RequestRetryFunc
+}
+
+type SynchronizationSchemaOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.SynchronizationSchema
+}
+
+type ParseExpressionRequest struct {
+	Expression                *string                     `json:"expression,omitempty"`
+	TargetAttributeDefinition *stable.AttributeDefinition `json:"targetAttributeDefinition,omitempty"`
+}
+
+type ParseExpressionOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.ParseExpressionResponse
+}
+
+// GetSynchronizationSchema - Retrieve the schema for a given synchronization job
+func (c SynchronizationSchemaClient) GetSynchronizationSchema(ctx context.Context, id stable.ServicePrincipalIdSynchronizationJobId, options SynchronizationSchemaOperationOptions) (result SynchronizationSchemaOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodGet, fmt.Sprintf("%s/schema", id.ID()), nil, false, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.SynchronizationSchema
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
+
+// SetSynchronizationSchema - Replace the schema for a given synchronization job. The schema is replaced in its entirety.
+func (c SynchronizationSchemaClient) SetSynchronizationSchema(ctx context.Context, id stable.ServicePrincipalIdSynchronizationJobId, input stable.SynchronizationSchema, options SynchronizationSchemaOperationOptions) (result SynchronizationSchemaOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodPut, fmt.Sprintf("%s/schema", id.ID()), input, false, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+// ResetSynchronizationSchema - Reset the schema for a given synchronization job to the default schema of its template
+func (c SynchronizationSchemaClient) ResetSynchronizationSchema(ctx context.Context, id stable.ServicePrincipalIdSynchronizationJobId, options SynchronizationSchemaOperationOptions) (result SynchronizationSchemaOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodDelete, fmt.Sprintf("%s/schema", id.ID()), nil, false, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+// ParseExpression - Parse a string expression into an attribute mapping source tree, which can be used in the
+// attribute mappings of a synchronization schema
+func (c SynchronizationSchemaClient) ParseExpression(ctx context.Context, id stable.ServicePrincipalIdSynchronizationJobId, input ParseExpressionRequest, options SynchronizationSchemaOperationOptions) (result ParseExpressionOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodPost, fmt.Sprintf("%s/schema/parseExpression", id.ID()), input, false, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.ParseExpressionResponse
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/graphrequest"
+)
+
+// SynchronizationTemplateClient lists the synchronization templates for a service principal, which are not yet covered by the SDK
+type SynchronizationTemplateClient struct {
+	Client *msgraph.Client
+}
+
+func NewSynchronizationTemplateClientWithBaseURI(api environments.Api) (*SynchronizationTemplateClient, error) {
+	c, err := msgraph.NewClient(api, "synchronizationtemplate", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating SynchronizationTemplateClient: %+v", err)
+	}
+
+	return &SynchronizationTemplateClient{
+		Client: c,
+	}, nil
+}
+
+type ListSynchronizationTemplatesOperationOptions struct {
+	RetryFunc client.RequestRetryFunc
+}
+
+type ListSynchronizationTemplatesOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.SynchronizationTemplate
+}
+
+// ListSynchronizationTemplates - List the synchronization templates associated with a given application instance (service principal)
+func (c SynchronizationTemplateClient) ListSynchronizationTemplates(ctx context.Context, id stable.ServicePrincipalId, options ListSynchronizationTemplatesOperationOptions) (result ListSynchronizationTemplatesOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodGet, fmt.Sprintf("%s/synchronization/templates", id.ID()), nil, true, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValues[stable.SynchronizationTemplate](resp)
+
+	return
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+type ScimProvisioningId struct {
+	ApplicationId      string
+	ServicePrincipalId string
+	JobId              string
+}
+
+func NewScimProvisioningID(applicationId, servicePrincipalId, jobId string) ScimProvisioningId {
+	return ScimProvisioningId{
+		ApplicationId:      applicationId,
+		ServicePrincipalId: servicePrincipalId,
+		JobId:              jobId,
+	}
+}
+
+// ParseScimProvisioningID parses 'input' into a ScimProvisioningId
+func ParseScimProvisioningID(input string) (*ScimProvisioningId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&ScimProvisioningId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := ScimProvisioningId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ValidateScimProvisioningID checks that 'input' can be parsed as a SCIM Provisioning ID
+func ValidateScimProvisioningID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := ParseScimProvisioningID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	if warnings, errors = validation.IsUUID(id.ApplicationId, "ID"); len(errors) > 0 {
+		return
+	}
+
+	if warnings, errors = validation.IsUUID(id.ServicePrincipalId, "ID"); len(errors) > 0 {
+		return
+	}
+
+	return
+}
+
+func (id ScimProvisioningId) ID() string {
+	fmtString := "/applications/%s/servicePrincipals/%s/synchronization/jobs/%s"
+	return fmt.Sprintf(fmtString, id.ApplicationId, id.ServicePrincipalId, id.JobId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id ScimProvisioningId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("applications", "applications", "applications"),
+		resourceids.UserSpecifiedSegment("applicationId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("servicePrincipals", "servicePrincipals", "servicePrincipals"),
+		resourceids.UserSpecifiedSegment("servicePrincipalId", "11111111-1111-1111-1111-111111111111"),
+		resourceids.StaticSegment("synchronization", "synchronization", "synchronization"),
+		resourceids.StaticSegment("jobs", "jobs", "jobs"),
+		resourceids.UserSpecifiedSegment("jobId", "scim.00000000000000000000000000000000.00000000-0000-0000-0000-000000000000"),
+	}
+}
+
+func (id ScimProvisioningId) String() string {
+	return fmt.Sprintf("SCIM Provisioning (Application ID: %q, Service Principal ID: %q, Job ID: %q)", id.ApplicationId, id.ServicePrincipalId, id.JobId)
+}
+
+func (id *ScimProvisioningId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.ApplicationId, ok = input.Parsed["applicationId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationId", input)
+	}
+
+	if id.ServicePrincipalId, ok = input.Parsed["servicePrincipalId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "servicePrincipalId", input)
+	}
+
+	if id.JobId, ok = input.Parsed["jobId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "jobId", input)
+	}
+
+	return nil
+}
@@ -30,7 +30,10 @@ func (r Registration) WebsiteCategories() []string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azuread_synchronization_job":       synchronizationJobDataSource(),
+		"azuread_synchronization_templates": synchronizationTemplatesDataSource(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
@@ -38,6 +41,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azuread_synchronization_job":                     synchronizationJobResource(),
 		"azuread_synchronization_job_provision_on_demand": synchronizationJobProvisionOnDemandResource(),
+		"azuread_synchronization_job_schema":              synchronizationJobSchemaResource(),
+		"azuread_synchronization_scim_provisioning":       synchronizationScimProvisioningResource(),
 		"azuread_synchronization_secret":                  synchronizationSecretResource(),
 	}
 }
@@ -4,7 +4,10 @@
 package synchronization
 
 import (
+	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -12,6 +15,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 )
 
 const servicePrincipalResourceName = "azuread_service_principal"
@@ -105,6 +110,265 @@ func flattenSynchronizationSchedule(in *stable.SynchronizationSchedule) []map[st
 	}}
 }
 
+func synchronizationJobStatusSchema() *pluginsdk.Schema {
+	executionSchema := func(description string) *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Description: description,
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"state": {
+						Description: "The result of the execution",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"started_at": {
+						Description: "The time when the execution started, formatted as an RFC3339 date string",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"ended_at": {
+						Description: "The time when the execution ended, formatted as an RFC3339 date string",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"escrowed_count": {
+						Description: "The number of objects which were escrowed during the execution",
+						Type:        pluginsdk.TypeInt,
+						Computed:    true,
+					},
+
+					"exported_count": {
+						Description: "The number of objects which were exported during the execution",
+						Type:        pluginsdk.TypeInt,
+						Computed:    true,
+					},
+
+					"imported_count": {
+						Description: "The number of objects which were imported during the execution",
+						Type:        pluginsdk.TypeInt,
+						Computed:    true,
+					},
+
+					"error_code": {
+						Description: "The error code, if the execution failed",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"error_message": {
+						Description: "The error message, if the execution failed",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		}
+	}
+
+	return &pluginsdk.Schema{
+		Description: "The current status of the synchronization job",
+		Type:        pluginsdk.TypeList,
+		Computed:    true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"code": {
+					Description: "The status code of the synchronization job",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+
+				"successive_failure_count": {
+					Description: "The number of consecutive times the synchronization job has failed",
+					Type:        pluginsdk.TypeInt,
+					Computed:    true,
+				},
+
+				"escrows_pruned": {
+					Description: "Whether escrowed objects were pruned during the last execution",
+					Type:        pluginsdk.TypeBool,
+					Computed:    true,
+				},
+
+				"steady_state_last_achieved_at": {
+					Description: "The time when steady state was last achieved, formatted as an RFC3339 date string",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+
+				"troubleshooting_url": {
+					Description: "A URL with troubleshooting steps for the current status",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+
+				"last_execution":            executionSchema("Details of the last execution of the synchronization job"),
+				"last_successful_execution": executionSchema("Details of the last successful execution of the synchronization job"),
+
+				"quarantine": {
+					Description: "Details of the quarantine, if the synchronization job is quarantined",
+					Type:        pluginsdk.TypeList,
+					Computed:    true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"reason": {
+								Description: "The reason for the quarantine",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+
+							"current_began_at": {
+								Description: "The time when the current quarantine began, formatted as an RFC3339 date string",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+
+							"series_began_at": {
+								Description: "The time when the first of a series of successive quarantines began, formatted as an RFC3339 date string",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+
+							"series_count": {
+								Description: "The number of successive quarantines",
+								Type:        pluginsdk.TypeInt,
+								Computed:    true,
+							},
+
+							"next_attempt_at": {
+								Description: "The time when the synchronization job will next be attempted, formatted as an RFC3339 date string",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+
+							"error_code": {
+								Description: "The error code which caused the quarantine",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+
+							"error_message": {
+								Description: "The error message which caused the quarantine",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+						},
+					},
+				},
+
+				"progress": {
+					Description: "The progress of the synchronization job towards completion",
+					Type:        pluginsdk.TypeList,
+					Computed:    true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"completed_units": {
+								Description: "The number of units completed",
+								Type:        pluginsdk.TypeInt,
+								Computed:    true,
+							},
+
+							"total_units": {
+								Description: "The total number of units",
+								Type:        pluginsdk.TypeInt,
+								Computed:    true,
+							},
+
+							"units": {
+								Description: "The kind of units being measured",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+
+							"observed_at": {
+								Description: "The time when the progress was observed, formatted as an RFC3339 date string",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenSynchronizationStatus(in *stable.SynchronizationStatus) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	quarantine := make([]map[string]interface{}, 0)
+	if in.Quarantine != nil {
+		var errorCode, errorMessage string
+		if in.Quarantine.Error != nil {
+			errorCode = in.Quarantine.Error.Code.GetOrZero()
+			errorMessage = in.Quarantine.Error.Message.GetOrZero()
+		}
+
+		quarantine = append(quarantine, map[string]interface{}{
+			"reason":           string(pointer.From(in.Quarantine.Reason)),
+			"current_began_at": pointer.From(in.Quarantine.CurrentBegan),
+			"series_began_at":  pointer.From(in.Quarantine.SeriesBegan),
+			"series_count":     int(pointer.From(in.Quarantine.SeriesCount)),
+			"next_attempt_at":  pointer.From(in.Quarantine.NextAttempt),
+			"error_code":       errorCode,
+			"error_message":    errorMessage,
+		})
+	}
+
+	progress := make([]map[string]interface{}, 0)
+	if in.Progress != nil {
+		for _, p := range *in.Progress {
+			progress = append(progress, map[string]interface{}{
+				"completed_units": int(pointer.From(p.CompletedUnits)),
+				"total_units":     int(pointer.From(p.TotalUnits)),
+				"units":           p.Units.GetOrZero(),
+				"observed_at":     pointer.From(p.ProgressObservationDateTime),
+			})
+		}
+	}
+
+	return []map[string]interface{}{{
+		"code":                          string(pointer.From(in.Code)),
+		"successive_failure_count":      int(pointer.From(in.CountSuccessiveCompleteFailures)),
+		"escrows_pruned":                pointer.From(in.EscrowsPruned),
+		"steady_state_last_achieved_at": pointer.From(in.SteadyStateLastAchievedTime),
+		"troubleshooting_url":           in.TroubleshootingUrl.GetOrZero(),
+		"last_execution":                flattenSynchronizationTaskExecution(in.LastExecution),
+		"last_successful_execution":     flattenSynchronizationTaskExecution(in.LastSuccessfulExecution),
+		"quarantine":                    quarantine,
+		"progress":                      progress,
+	}}
+}
+
+func flattenSynchronizationTaskExecution(in *stable.SynchronizationTaskExecution) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	var errorCode, errorMessage string
+	if in.Error != nil {
+		errorCode = in.Error.Code.GetOrZero()
+		errorMessage = in.Error.Message.GetOrZero()
+	}
+
+	return []map[string]interface{}{{
+		"state":          string(pointer.From(in.State)),
+		"started_at":     pointer.From(in.TimeBegan),
+		"ended_at":       pointer.From(in.TimeEnded),
+		"escrowed_count": int(pointer.From(in.CountEscrowed)),
+		"exported_count": int(pointer.From(in.CountExported)),
+		"imported_count": int(pointer.From(in.CountImported)),
+		"error_code":     errorCode,
+		"error_message":  errorMessage,
+	}}
+}
+
 func flattenSynchronizationSecretKeyStringValuePair(in *[]stable.SynchronizationSecretKeyStringValuePair, current []interface{}) []interface{} {
 	if in == nil {
 		return []interface{}{}
@@ -146,3 +410,367 @@ func allCredentialsRemoved(in []stable.SynchronizationSecretKeyStringValuePair,
 
 	return true
 }
+
+func expandSynchronizationRules(in []interface{}, schema *stable.SynchronizationSchema, parseExpression func(string) (*stable.AttributeMappingSource, error)) error {
+	if schema.SynchronizationRules == nil {
+		schema.SynchronizationRules = &[]stable.SynchronizationRule{}
+	}
+	rules := *schema.SynchronizationRules
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		item := raw.(map[string]interface{})
+		ruleId := item["id"].(string)
+
+		ruleIndex := -1
+		for i, rule := range rules {
+			if strings.EqualFold(rule.Id.GetOrZero(), ruleId) {
+				ruleIndex = i
+				break
+			}
+		}
+		if ruleIndex == -1 {
+			return fmt.Errorf("synchronization rule %q was not found in the job schema", ruleId)
+		}
+
+		if rules[ruleIndex].ObjectMappings == nil {
+			rules[ruleIndex].ObjectMappings = &[]stable.ObjectMapping{}
+		}
+		objectMappings := *rules[ruleIndex].ObjectMappings
+
+		for _, rawMapping := range item["object_mapping"].([]interface{}) {
+			if rawMapping == nil {
+				continue
+			}
+			mappingItem := rawMapping.(map[string]interface{})
+			sourceObjectName := mappingItem["source_object_name"].(string)
+			targetObjectName := mappingItem["target_object_name"].(string)
+
+			mappingIndex := -1
+			for i, objectMapping := range objectMappings {
+				if strings.EqualFold(objectMapping.SourceObjectName.GetOrZero(), sourceObjectName) && strings.EqualFold(objectMapping.TargetObjectName.GetOrZero(), targetObjectName) {
+					mappingIndex = i
+					break
+				}
+			}
+			if mappingIndex == -1 {
+				return fmt.Errorf("object mapping from %q to %q was not found in synchronization rule %q", sourceObjectName, targetObjectName, ruleId)
+			}
+
+			objectMapping := &objectMappings[mappingIndex]
+			objectMapping.Enabled = pointer.To(mappingItem["enabled"].(bool))
+
+			if flowTypes := tf.ExpandStringSlice(mappingItem["flow_types"].(*pluginsdk.Set).List()); len(flowTypes) > 0 {
+				sort.Strings(flowTypes)
+				objectMapping.FlowTypes = pointer.To(stable.ObjectFlowTypes(strings.Join(flowTypes, ",")))
+			}
+
+			// Scoping filters are only replaced when specified, so that filters configured elsewhere are retained
+			if scopingFilters := mappingItem["scoping_filter"].([]interface{}); len(scopingFilters) > 0 {
+				if objectMapping.Scope == nil {
+					objectMapping.Scope = &stable.Filter{}
+				}
+				objectMapping.Scope.Groups = expandSynchronizationFilterGroups(scopingFilters)
+			}
+
+			// Attribute mappings are only replaced when specified, since an object mapping without any attribute
+			// mappings cannot be synchronized
+			if attributeMappings := mappingItem["attribute_mapping"].([]interface{}); len(attributeMappings) > 0 {
+				result, err := expandSynchronizationAttributeMappings(attributeMappings, parseExpression)
+				if err != nil {
+					return fmt.Errorf("object mapping from %q to %q: %+v", sourceObjectName, targetObjectName, err)
+				}
+				objectMapping.AttributeMappings = result
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandSynchronizationFilterGroups(in []interface{}) *[]stable.FilterGroup {
+	result := make([]stable.FilterGroup, 0)
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		item := raw.(map[string]interface{})
+
+		clauses := make([]stable.FilterClause, 0)
+		for _, rawClause := range item["clause"].([]interface{}) {
+			if rawClause == nil {
+				continue
+			}
+			clause := rawClause.(map[string]interface{})
+
+			clauses = append(clauses, stable.FilterClause{
+				OperatorName:      nullable.Value(clause["operator_name"].(string)),
+				SourceOperandName: nullable.Value(clause["source_operand_name"].(string)),
+				TargetOperand: &stable.FilterOperand{
+					Values: tf.ExpandStringSlicePtr(clause["target_values"].([]interface{})),
+				},
+			})
+		}
+
+		result = append(result, stable.FilterGroup{
+			Clauses: &clauses,
+			Name:    nullable.NoZero(item["name"].(string)),
+		})
+	}
+
+	return &result
+}
+
+func expandSynchronizationAttributeMappings(in []interface{}, parseExpression func(string) (*stable.AttributeMappingSource, error)) (*[]stable.AttributeMapping, error) {
+	result := make([]stable.AttributeMapping, 0)
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		item := raw.(map[string]interface{})
+		targetAttributeName := item["target_attribute_name"].(string)
+
+		sourceAttributeName := item["source_attribute_name"].(string)
+		constantValue := item["constant_value"].(string)
+		expression := item["expression"].(string)
+
+		specified := 0
+		for _, v := range []string{sourceAttributeName, constantValue, expression} {
+			if v != "" {
+				specified++
+			}
+		}
+		if specified != 1 {
+			return nil, fmt.Errorf("exactly one of `source_attribute_name`, `constant_value` or `expression` must be specified for the mapping to %q", targetAttributeName)
+		}
+
+		var source *stable.AttributeMappingSource
+		switch {
+		case sourceAttributeName != "":
+			source = &stable.AttributeMappingSource{
+				Name:       nullable.Value(sourceAttributeName),
+				Parameters: &[]stable.StringKeyAttributeMappingSourceValuePair{},
+				Type:       pointer.To(stable.AttributeMappingSourceType_Attribute),
+			}
+		case constantValue != "":
+			source = &stable.AttributeMappingSource{
+				Name:       nullable.Value(constantValue),
+				Parameters: &[]stable.StringKeyAttributeMappingSourceValuePair{},
+				Type:       pointer.To(stable.AttributeMappingSourceType_Constant),
+			}
+		default:
+			parsed, err := parseExpression(expression)
+			if err != nil {
+				return nil, err
+			}
+			source = parsed
+		}
+
+		result = append(result, stable.AttributeMapping{
+			DefaultValue:            nullable.NoZero(item["default_value"].(string)),
+			ExportMissingReferences: pointer.To(false),
+			FlowBehavior:            pointer.To(stable.AttributeFlowBehavior(item["flow_behavior"].(string))),
+			FlowType:                pointer.To(stable.AttributeFlowType(item["flow_type"].(string))),
+			MatchingPriority:        pointer.To(int64(item["matching_priority"].(int))),
+			Source:                  source,
+			TargetAttributeName:     nullable.Value(targetAttributeName),
+		})
+	}
+
+	return &result, nil
+}
+
+// flattenSynchronizationRules flattens only the rules and object mappings present in the current configuration, since
+// job schemas contain many rules and mappings that are not managed. When there is no current configuration (i.e. on
+// import), all rules are flattened.
+func flattenSynchronizationRules(in *[]stable.SynchronizationRule, current []interface{}) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	if len(current) == 0 {
+		for _, rule := range *in {
+			objectMappings := make([]interface{}, 0)
+			if rule.ObjectMappings != nil {
+				for _, objectMapping := range *rule.ObjectMappings {
+					objectMappings = append(objectMappings, flattenSynchronizationObjectMapping(objectMapping, nil, true))
+				}
+			}
+
+			result = append(result, map[string]interface{}{
+				"id":             rule.Id.GetOrZero(),
+				"object_mapping": objectMappings,
+			})
+		}
+
+		return result
+	}
+
+	for _, raw := range current {
+		if raw == nil {
+			continue
+		}
+		item := raw.(map[string]interface{})
+		ruleId := item["id"].(string)
+
+		var rule *stable.SynchronizationRule
+		for i := range *in {
+			if strings.EqualFold((*in)[i].Id.GetOrZero(), ruleId) {
+				rule = &(*in)[i]
+				break
+			}
+		}
+		if rule == nil {
+			continue
+		}
+
+		objectMappings := make([]interface{}, 0)
+		for _, rawMapping := range item["object_mapping"].([]interface{}) {
+			if rawMapping == nil || rule.ObjectMappings == nil {
+				continue
+			}
+			mappingItem := rawMapping.(map[string]interface{})
+
+			for _, objectMapping := range *rule.ObjectMappings {
+				if strings.EqualFold(objectMapping.SourceObjectName.GetOrZero(), mappingItem["source_object_name"].(string)) && strings.EqualFold(objectMapping.TargetObjectName.GetOrZero(), mappingItem["target_object_name"].(string)) {
+					currentAttributeMappings := mappingItem["attribute_mapping"].([]interface{})
+					objectMappings = append(objectMappings, flattenSynchronizationObjectMapping(objectMapping, currentAttributeMappings, len(currentAttributeMappings) > 0))
+					break
+				}
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":             ruleId,
+			"object_mapping": objectMappings,
+		})
+	}
+
+	return result
+}
+
+func flattenSynchronizationObjectMapping(in stable.ObjectMapping, currentAttributeMappings []interface{}, includeAttributeMappings bool) map[string]interface{} {
+	flowTypes := make([]string, 0)
+	if in.FlowTypes != nil {
+		for _, flowType := range strings.Split(string(*in.FlowTypes), ",") {
+			if flowType = strings.TrimSpace(flowType); flowType != "" && flowType != string(stable.ObjectFlowTypes_None) {
+				flowTypes = append(flowTypes, flowType)
+			}
+		}
+	}
+
+	scopingFilters := make([]interface{}, 0)
+	if in.Scope != nil && in.Scope.Groups != nil {
+		for _, group := range *in.Scope.Groups {
+			clauses := make([]interface{}, 0)
+			if group.Clauses != nil {
+				for _, clause := range *group.Clauses {
+					var targetValues []string
+					if clause.TargetOperand != nil {
+						targetValues = pointer.From(clause.TargetOperand.Values)
+					}
+					clauses = append(clauses, map[string]interface{}{
+						"operator_name":       clause.OperatorName.GetOrZero(),
+						"source_operand_name": clause.SourceOperandName.GetOrZero(),
+						"target_values":       targetValues,
+					})
+				}
+			}
+
+			scopingFilters = append(scopingFilters, map[string]interface{}{
+				"name":   group.Name.GetOrZero(),
+				"clause": clauses,
+			})
+		}
+	}
+
+	attributeMappings := make([]interface{}, 0)
+	if includeAttributeMappings && in.AttributeMappings != nil {
+		attributeMappings = flattenSynchronizationAttributeMappings(*in.AttributeMappings, currentAttributeMappings)
+	}
+
+	return map[string]interface{}{
+		"source_object_name": in.SourceObjectName.GetOrZero(),
+		"target_object_name": in.TargetObjectName.GetOrZero(),
+		"enabled":            pointer.From(in.Enabled),
+		"flow_types":         flowTypes,
+		"scoping_filter":     scopingFilters,
+		"attribute_mapping":  attributeMappings,
+	}
+}
+
+func flattenSynchronizationAttributeMappings(in []stable.AttributeMapping, current []interface{}) []interface{} {
+	// Preserve the configured order of attribute mappings, followed by any that are not configured
+	currentExpressions := make(map[string]string)
+	order := make([]string, 0)
+	for _, raw := range current {
+		if raw == nil {
+			continue
+		}
+		item := raw.(map[string]interface{})
+		targetAttributeName := strings.ToLower(item["target_attribute_name"].(string))
+		order = append(order, targetAttributeName)
+		currentExpressions[targetAttributeName] = item["expression"].(string)
+	}
+
+	sorted := make([]stable.AttributeMapping, 0, len(in))
+	seen := make(map[int]bool)
+	for _, targetAttributeName := range order {
+		for i, attributeMapping := range in {
+			if !seen[i] && strings.EqualFold(attributeMapping.TargetAttributeName.GetOrZero(), targetAttributeName) {
+				sorted = append(sorted, attributeMapping)
+				seen[i] = true
+				break
+			}
+		}
+	}
+	for i, attributeMapping := range in {
+		if !seen[i] {
+			sorted = append(sorted, attributeMapping)
+		}
+	}
+
+	result := make([]interface{}, 0, len(sorted))
+	for _, attributeMapping := range sorted {
+		targetAttributeName := attributeMapping.TargetAttributeName.GetOrZero()
+
+		var sourceAttributeName, constantValue, expression string
+		if source := attributeMapping.Source; source != nil {
+			switch pointer.From(source.Type) {
+			case stable.AttributeMappingSourceType_Attribute:
+				sourceAttributeName = source.Name.GetOrZero()
+			case stable.AttributeMappingSourceType_Constant:
+				constantValue = source.Name.GetOrZero()
+			default:
+				expression = source.Expression.GetOrZero()
+
+				// The service normalizes expressions, so retain the configured expression when it is equivalent
+				if v, ok := currentExpressions[strings.ToLower(targetAttributeName)]; ok && synchronizationExpressionsEquivalent(v, expression) {
+					expression = v
+				}
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"target_attribute_name": targetAttributeName,
+			"source_attribute_name": sourceAttributeName,
+			"constant_value":        constantValue,
+			"expression":            expression,
+			"default_value":         attributeMapping.DefaultValue.GetOrZero(),
+			"flow_behavior":         string(pointer.From(attributeMapping.FlowBehavior)),
+			"flow_type":             string(pointer.From(attributeMapping.FlowType)),
+			"matching_priority":     int(pointer.From(attributeMapping.MatchingPriority)),
+		})
+	}
+
+	return result
+}
+
+func synchronizationExpressionsEquivalent(a, b string) bool {
+	return strings.Join(strings.Fields(a), "") == strings.Join(strings.Fields(b), "")
+}
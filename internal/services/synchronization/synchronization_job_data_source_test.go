@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type SynchronizationJobDataSource struct{}
+
+func TestAccSynchronizationJobDataSource_byJobId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_synchronization_job", "test")
+	r := SynchronizationJobDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byJobId(data),
+			Check:  r.testCheckFunc(data),
+		},
+	})
+}
+
+func TestAccSynchronizationJobDataSource_byTemplateId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_synchronization_job", "test")
+	r := SynchronizationJobDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byTemplateId(data),
+			Check:  r.testCheckFunc(data),
+		},
+	})
+}
+
+func (SynchronizationJobDataSource) testCheckFunc(data acceptance.TestData) acceptance.TestCheckFunc {
+	return acceptance.ComposeTestCheckFunc(
+		check.That(data.ResourceName).Key("id").MatchesOtherKey(check.That("azuread_synchronization_job.test").Key("id")),
+		check.That(data.ResourceName).Key("template_id").HasValue("dataBricks"),
+		check.That(data.ResourceName).Key("enabled").HasValue("true"),
+		check.That(data.ResourceName).Key("status.0.code").Exists(),
+	)
+}
+
+func (SynchronizationJobDataSource) byJobId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_synchronization_job" "test" {
+  service_principal_id = data.azuread_service_principal.test.id
+  job_id               = element(split("/", azuread_synchronization_job.test.id), 4)
+}
+`, SynchronizationJobResource{}.basic(data))
+}
+
+func (SynchronizationJobDataSource) byTemplateId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_synchronization_job" "test" {
+  service_principal_id = data.azuread_service_principal.test.id
+  template_id          = azuread_synchronization_job.test.template_id
+}
+`, SynchronizationJobResource{}.basic(data))
+}
@@ -9,6 +9,9 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -21,6 +24,7 @@ import (
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
 	"github.com/valiparsa/terraform-provider-azuread/internal/services/synchronization/migrations"
 )
 
@@ -31,6 +35,8 @@ func synchronizationJobResource() *pluginsdk.Resource {
 		UpdateContext: synchronizationJobResourceUpdate,
 		DeleteContext: synchronizationJobResourceDelete,
 
+		CustomizeDiff: synchronizationJobResourceCustomizeDiff,
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(15 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -83,7 +89,9 @@ func synchronizationJobResource() *pluginsdk.Resource {
 
 			"schedule": {
 				Type:     pluginsdk.TypeList,
+				Optional: true,
 				Computed: true,
+				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"expiration": {
@@ -93,9 +101,11 @@ func synchronizationJobResource() *pluginsdk.Resource {
 						},
 
 						"interval": {
-							Description: "The interval between synchronization iterations ISO8601. E.g. PT40M run every 40 minutes.",
-							Type:        pluginsdk.TypeString,
-							Computed:    true,
+							Description:  "The interval between synchronization iterations ISO8601. E.g. PT40M run every 40 minutes.",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^P(\d+D(T(\d+H(\d+M)?(\d+S)?|\d+M(\d+S)?|\d+S))?|T(\d+H(\d+M)?(\d+S)?|\d+M(\d+S)?|\d+S))$`), "must be an ISO8601 duration, e.g. `PT40M`"),
 						},
 
 						"state": {
@@ -106,10 +116,82 @@ func synchronizationJobResource() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"restart": {
+				Description: "Restarts the synchronization job when the `trigger` value changes",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"trigger": {
+							Description:  "An arbitrary value which, when changed, restarts the synchronization job",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"scopes": {
+							Description: "What to reset when restarting the synchronization job",
+							Type:        pluginsdk.TypeSet,
+							Optional:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(stable.SynchronizationJobRestartScope_ConnectorDataStore),
+									string(stable.SynchronizationJobRestartScope_Escrows),
+									string(stable.SynchronizationJobRestartScope_ForceDeletes),
+									string(stable.SynchronizationJobRestartScope_Full),
+									string(stable.SynchronizationJobRestartScope_QuarantineState),
+									string(stable.SynchronizationJobRestartScope_Watermark),
+								}, false),
+							},
+						},
+					},
+				},
+			},
+
+			"quarantined": {
+				Description: "Whether the synchronization job is currently quarantined",
+				Type:        pluginsdk.TypeBool,
+				Computed:    true,
+			},
+
+			"status": synchronizationJobStatusSchema(),
 		},
 	}
 }
 
+func synchronizationJobResourceCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	enabled := diff.Get("enabled").(bool)
+	restart := diff.HasChange("restart.0.trigger") && diff.Get("restart.0.trigger").(string) != ""
+
+	// Disabled jobs are never restarted, so a changed trigger would otherwise be silently discarded
+	if restart && !enabled {
+		return fmt.Errorf("`restart.0.trigger` cannot be changed whilst `enabled` is false, since disabled synchronization jobs are not restarted")
+	}
+
+	if !enabled || !diff.Get("quarantined").(bool) {
+		return nil
+	}
+
+	// A quarantined job must be explicitly restarted (or disabled), so that it does not silently remain broken
+	if restart && diff.Get("restart.0.scopes").(*pluginsdk.Set).Contains(string(stable.SynchronizationJobRestartScope_QuarantineState)) {
+		return diff.SetNewComputed("quarantined")
+	}
+
+	reason := ""
+	if v := diff.Get("status.0.quarantine.0.reason").(string); v != "" {
+		reason = fmt.Sprintf(" (reason: %s)", v)
+	}
+
+	return fmt.Errorf("synchronization job %q is quarantined%s: change `restart.0.trigger` and include %q in `restart.0.scopes` to restart it, or set `enabled` to false", diff.Id(), reason, stable.SynchronizationJobRestartScope_QuarantineState)
+}
+
 func synchronizationJobResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Synchronization.SynchronizationJobClient
 	servicePrincipalClient := meta.(*clients.Client).Synchronization.ServicePrincipalClient
@@ -168,6 +250,16 @@ func synchronizationJobResourceCreate(ctx context.Context, d *pluginsdk.Resource
 
 	d.SetId(id.ID())
 
+	if interval := d.Get("schedule.0.interval").(string); interval != "" {
+		if _, err = client.UpdateSynchronizationJob(ctx, id, stable.SynchronizationJob{
+			Schedule: &stable.SynchronizationSchedule{
+				Interval: pointer.To(interval),
+			},
+		}, synchronizationjob.UpdateSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+			return tf.ErrorDiagF(err, "Setting schedule interval for %s", id)
+		}
+	}
+
 	// Start job if desired
 	if d.Get("enabled").(bool) {
 		if _, err = client.StartSynchronizationJob(ctx, id, synchronizationjob.StartSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
@@ -205,6 +297,8 @@ func synchronizationJobResourceRead(ctx context.Context, d *pluginsdk.ResourceDa
 
 	tf.Set(d, "service_principal_id", servicePrincipalId.ID())
 	tf.Set(d, "schedule", flattenSynchronizationSchedule(synchronizationJob.Schedule))
+	tf.Set(d, "status", flattenSynchronizationStatus(synchronizationJob.Status))
+	tf.Set(d, "quarantined", synchronizationJob.Status != nil && pointer.From(synchronizationJob.Status.Code) == stable.SynchronizationStatusCode_Quarantine)
 	tf.Set(d, "template_id", synchronizationJob.TemplateId.GetOrZero())
 	tf.Set(d, "enabled", pointer.From(synchronizationJob.Schedule.State) == stable.SynchronizationScheduleState_Active)
 	return nil
@@ -218,6 +312,21 @@ func synchronizationJobResourceUpdate(ctx context.Context, d *pluginsdk.Resource
 		return tf.ErrorDiagPathF(err, "id", "Parsing synchronization job ID %q", d.Id())
 	}
 
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	if d.HasChange("schedule.0.interval") {
+		if interval := d.Get("schedule.0.interval").(string); interval != "" {
+			if _, err = client.UpdateSynchronizationJob(ctx, *id, stable.SynchronizationJob{
+				Schedule: &stable.SynchronizationSchedule{
+					Interval: pointer.To(interval),
+				},
+			}, synchronizationjob.UpdateSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+				return tf.ErrorDiagF(err, "Setting schedule interval for %s", id)
+			}
+		}
+	}
+
 	if d.HasChange("enabled") {
 		if d.Get("enabled").(bool) {
 			if _, err = client.StartSynchronizationJob(ctx, *id, synchronizationjob.StartSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
@@ -230,6 +339,21 @@ func synchronizationJobResourceUpdate(ctx context.Context, d *pluginsdk.Resource
 		}
 	}
 
+	// Restarts are only performed for enabled jobs, when the restart trigger changes
+	if d.Get("enabled").(bool) && d.HasChange("restart.0.trigger") && d.Get("restart.0.trigger").(string) != "" {
+		input := synchronizationjob.RestartSynchronizationJobRequest{}
+		if scopes := tf.ExpandStringSlice(d.Get("restart.0.scopes").(*pluginsdk.Set).List()); len(scopes) > 0 {
+			sort.Strings(scopes)
+			input.Criteria = &stable.SynchronizationJobRestartCriteria{
+				ResetScope: pointer.To(stable.SynchronizationJobRestartScope(strings.Join(scopes, ","))),
+			}
+		}
+
+		if _, err = client.RestartSynchronizationJob(ctx, *id, input, synchronizationjob.RestartSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+			return tf.ErrorDiagF(err, "Restarting %s", id)
+		}
+	}
+
 	return synchronizationJobResourceRead(ctx, d, meta)
 }
 
@@ -26,6 +26,7 @@ func TestAccSynchronizationJob(t *testing.T) {
 		"synchronizationJob": {
 			"basic":    testAccSynchronizationJob_basic,
 			"disabled": testAccSynchronizationJob_disabled,
+			"restart":  testAccSynchronizationJob_restart,
 		},
 	})
 }
@@ -64,6 +65,39 @@ func testAccSynchronizationJob_disabled(t *testing.T) {
 	})
 }
 
+func testAccSynchronizationJob_restart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_job", "test")
+	r := SynchronizationJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status.0.code").Exists(),
+				check.That(data.ResourceName).Key("quarantined").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.restart(data, "first", "PT40M"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.0.interval").HasValue("PT40M"),
+			),
+		},
+		data.ImportStep("restart"),
+		{
+			Config: r.restart(data, "second", "PT1H"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.0.interval").HasValue("PT1H"),
+			),
+		},
+		data.ImportStep("restart"),
+	})
+}
+
 func (r SynchronizationJobResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.ServicePrincipals.SynchronizationJobClient
 
@@ -126,3 +160,23 @@ resource "azuread_synchronization_job" "test" {
 }
 `, r.template(data))
 }
+
+func (r SynchronizationJobResource) restart(data acceptance.TestData, trigger, interval string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_synchronization_job" "test" {
+  service_principal_id = data.azuread_service_principal.test.id
+  template_id          = "dataBricks"
+
+  schedule {
+    interval = "%[3]s"
+  }
+
+  restart {
+    trigger = "%[2]s"
+    scopes  = ["Escrows", "Watermark"]
+  }
+}
+`, r.template(data), trigger, interval)
+}
@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	synchronizationClient "github.com/valiparsa/terraform-provider-azuread/internal/services/synchronization/client"
+)
+
+func synchronizationJobSchemaResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: synchronizationJobSchemaResourceCreate,
+		ReadContext:   synchronizationJobSchemaResourceRead,
+		UpdateContext: synchronizationJobSchemaResourceUpdate,
+		DeleteContext: synchronizationJobSchemaResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateServicePrincipalIdSynchronizationJobID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return errors.New(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"synchronization_job_id": {
+				Description:  "The ID of the synchronization job for which to manage the schema",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: stable.ValidateServicePrincipalIdSynchronizationJobID,
+			},
+
+			"synchronization_rule": {
+				Description:  "One or more synchronization rules to customize",
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"synchronization_rule", "synchronization_rules_json"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description:  "The identifier of the synchronization rule, which must already exist in the job schema",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"object_mapping": {
+							Description: "One or more object mappings to customize",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"source_object_name": {
+										Description:  "The name of the object in the source directory",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"target_object_name": {
+										Description:  "The name of the object in the target directory",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"enabled": {
+										Description: "Whether this object mapping is processed during synchronization",
+										Type:        pluginsdk.TypeBool,
+										Optional:    true,
+										Default:     true,
+									},
+
+									"flow_types": {
+										Description: "The types of changes that should be synchronized for this object mapping",
+										Type:        pluginsdk.TypeSet,
+										Optional:    true,
+										Computed:    true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(stable.ObjectFlowTypes_Add),
+												string(stable.ObjectFlowTypes_Delete),
+												string(stable.ObjectFlowTypes_Update),
+											}, false),
+										},
+									},
+
+									"scoping_filter": {
+										Description: "Scoping filter groups, any of which must be satisfied for an object to be in scope",
+										Type:        pluginsdk.TypeList,
+										Optional:    true,
+										Computed:    true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"name": {
+													Description: "The name of the scoping filter group",
+													Type:        pluginsdk.TypeString,
+													Optional:    true,
+												},
+
+												"clause": {
+													Description: "Clauses for this scoping filter group, all of which must be satisfied",
+													Type:        pluginsdk.TypeList,
+													Required:    true,
+													MinItems:    1,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"source_operand_name": {
+																Description:  "The name of the source attribute being tested",
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringIsNotEmpty,
+															},
+
+															"operator_name": {
+																Description:  "The name of the operator to apply, e.g. `EQUALS` or `IS TRUE`",
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringIsNotEmpty,
+															},
+
+															"target_values": {
+																Description: "The values against which the source attribute is tested",
+																Type:        pluginsdk.TypeList,
+																Optional:    true,
+																Elem: &pluginsdk.Schema{
+																	Type: pluginsdk.TypeString,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+
+									"attribute_mapping": {
+										Description: "Attribute mappings for this object mapping. When specified, these replace all existing attribute mappings for the object mapping",
+										Type:        pluginsdk.TypeList,
+										Optional:    true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"target_attribute_name": {
+													Description:  "The name of the attribute on the target object",
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+
+												"source_attribute_name": {
+													Description: "The name of the source attribute for a direct mapping",
+													Type:        pluginsdk.TypeString,
+													Optional:    true,
+												},
+
+												"constant_value": {
+													Description: "The constant value for a constant mapping",
+													Type:        pluginsdk.TypeString,
+													Optional:    true,
+												},
+
+												"expression": {
+													Description: "The expression for an expression mapping",
+													Type:        pluginsdk.TypeString,
+													Optional:    true,
+												},
+
+												"default_value": {
+													Description: "The value to use when the source evaluates to null",
+													Type:        pluginsdk.TypeString,
+													Optional:    true,
+												},
+
+												"flow_behavior": {
+													Description:  "When the value of this attribute should be synchronized",
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													Default:      string(stable.AttributeFlowBehavior_FlowWhenChanged),
+													ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAttributeFlowBehavior(), false),
+												},
+
+												"flow_type": {
+													Description:  "How the value of this attribute should be synchronized",
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													Default:      string(stable.AttributeFlowType_Always),
+													ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAttributeFlowType(), false),
+												},
+
+												"matching_priority": {
+													Description:  "When higher than 0, this attribute is used to match objects between the source and target directories",
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													Default:      0,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"synchronization_rules_json": {
+				Description:      "A JSON-encoded array of synchronization rules, which replaces all synchronization rules in the job schema. Changes made outside of Terraform are not detected",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"synchronization_rule", "synchronization_rules_json"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			},
+
+			"version": {
+				Description: "The version of the synchronization schema",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func synchronizationJobSchemaResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	id, err := stable.ParseServicePrincipalIdSynchronizationJobID(d.Get("synchronization_job_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "synchronization_job_id", "Parsing `synchronization_job_id`")
+	}
+
+	if diags := synchronizationJobSchemaApply(ctx, d, meta, *id); diags != nil {
+		return diags
+	}
+
+	d.SetId(id.ID())
+
+	return synchronizationJobSchemaResourceRead(ctx, d, meta)
+}
+
+func synchronizationJobSchemaResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	id, err := stable.ParseServicePrincipalIdSynchronizationJobID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing synchronization job ID %q", d.Id())
+	}
+
+	if diags := synchronizationJobSchemaApply(ctx, d, meta, *id); diags != nil {
+		return diags
+	}
+
+	return synchronizationJobSchemaResourceRead(ctx, d, meta)
+}
+
+// synchronizationJobSchemaApply retrieves the current schema for the job, merges in the configured rules and replaces
+// the schema. Rules and object mappings which are not configured are left unchanged.
+func synchronizationJobSchemaApply(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id stable.ServicePrincipalIdSynchronizationJobId) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Synchronization.SynchronizationSchemaClient
+	options := synchronizationClient.SynchronizationSchemaOperationOptions{RetryFunc: synchronizationRetryFunc()}
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	resp, err := client.GetSynchronizationSchema(ctx, id, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "synchronization_job_id", "Schema for %s was not found", id)
+		}
+		return tf.ErrorDiagF(err, "Retrieving schema for %s", id)
+	}
+
+	schema := resp.Model
+	if schema == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving schema for %s", id)
+	}
+
+	if v := d.Get("synchronization_rules_json").(string); v != "" {
+		rules := make([]stable.SynchronizationRule, 0)
+		if err = json.Unmarshal([]byte(v), &rules); err != nil {
+			return tf.ErrorDiagPathF(err, "synchronization_rules_json", "Parsing `synchronization_rules_json`")
+		}
+		schema.SynchronizationRules = &rules
+	} else {
+		parseExpression := func(expression string) (*stable.AttributeMappingSource, error) {
+			return synchronizationJobSchemaParseExpression(ctx, client, id, expression)
+		}
+		if err = expandSynchronizationRules(d.Get("synchronization_rule").([]interface{}), schema, parseExpression); err != nil {
+			return tf.ErrorDiagPathF(err, "synchronization_rule", "Building schema for %s", id)
+		}
+	}
+
+	// The version is assigned by the service
+	schema.Version = nil
+
+	if _, err = client.SetSynchronizationSchema(ctx, id, *schema, options); err != nil {
+		return tf.ErrorDiagF(err, "Updating schema for %s", id)
+	}
+
+	return nil
+}
+
+func synchronizationJobSchemaParseExpression(ctx context.Context, client *synchronizationClient.SynchronizationSchemaClient, id stable.ServicePrincipalIdSynchronizationJobId, expression string) (*stable.AttributeMappingSource, error) {
+	resp, err := client.ParseExpression(ctx, id, synchronizationClient.ParseExpressionRequest{
+		Expression: &expression,
+	}, synchronizationClient.SynchronizationSchemaOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		return nil, fmt.Errorf("parsing expression %q: %+v", expression, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("parsing expression %q: model was nil", expression)
+	}
+	if resp.Model.ParsingSucceeded == nil || !*resp.Model.ParsingSucceeded || resp.Model.ParsedExpression == nil {
+		message := "unknown error"
+		if resp.Model.Error != nil {
+			message = resp.Model.Error.Message.GetOrZero()
+		}
+		return nil, fmt.Errorf("parsing expression %q: %s", expression, message)
+	}
+
+	return resp.Model.ParsedExpression, nil
+}
+
+func synchronizationJobSchemaResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Synchronization.SynchronizationSchemaClient
+
+	id, err := stable.ParseServicePrincipalIdSynchronizationJobID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing synchronization job ID %q", d.Id())
+	}
+
+	resp, err := client.GetSynchronizationSchema(ctx, *id, synchronizationClient.SynchronizationSchemaOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] Schema for %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving schema for %s", id)
+	}
+
+	schema := resp.Model
+	if schema == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving schema for %s", id)
+	}
+
+	tf.Set(d, "synchronization_job_id", id.ID())
+	tf.Set(d, "version", schema.Version.GetOrZero())
+
+	// Changes made outside of Terraform cannot be detected when the raw JSON is used, since the service adds a
+	// significant amount of metadata to the rules
+	if d.Get("synchronization_rules_json").(string) == "" {
+		tf.Set(d, "synchronization_rule", flattenSynchronizationRules(schema.SynchronizationRules, d.Get("synchronization_rule").([]interface{})))
+	}
+
+	return nil
+}
+
+func synchronizationJobSchemaResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Synchronization.SynchronizationSchemaClient
+
+	id, err := stable.ParseServicePrincipalIdSynchronizationJobID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing synchronization job ID %q", d.Id())
+	}
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	// Deleting the schema resets it to the default schema for the job template
+	if resp, err := client.ResetSynchronizationSchema(ctx, *id, synchronizationClient.SynchronizationSchemaOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Resetting schema for %s", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	synchronizationClient "github.com/valiparsa/terraform-provider-azuread/internal/services/synchronization/client"
+)
+
+type SynchronizationJobSchemaResource struct {
+	RuleId string
+}
+
+func TestAccSynchronizationJobSchema_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_job_schema", "test")
+	r := newSynchronizationJobSchemaResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("synchronization_rule.0.object_mapping.0.attribute_mapping.#").HasValue("3"),
+				check.That(data.ResourceName).Key("version").Exists(),
+			),
+		},
+		data.ImportStep("synchronization_rule"),
+	})
+}
+
+func TestAccSynchronizationJobSchema_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_job_schema", "test")
+	r := newSynchronizationJobSchemaResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("synchronization_rule"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("synchronization_rule.0.object_mapping.0.scoping_filter.#").HasValue("1"),
+				check.That(data.ResourceName).Key("synchronization_rule.0.object_mapping.0.attribute_mapping.#").HasValue("4"),
+			),
+		},
+		data.ImportStep("synchronization_rule"),
+		{
+			// Omitting the scoping filters should leave the existing filters in place
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("synchronization_rule.0.object_mapping.0.scoping_filter.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("synchronization_rule"),
+	})
+}
+
+func newSynchronizationJobSchemaResource(t *testing.T) SynchronizationJobSchemaResource {
+	ruleId := os.Getenv("ARM_TEST_SYNCHRONIZATION_RULE_ID")
+	if ruleId == "" {
+		t.Skip("ARM_TEST_SYNCHRONIZATION_RULE_ID must be set to run this test")
+	}
+
+	return SynchronizationJobSchemaResource{
+		RuleId: ruleId,
+	}
+}
+
+func (r SynchronizationJobSchemaResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Synchronization.SynchronizationSchemaClient
+
+	id, err := stable.ParseServicePrincipalIdSynchronizationJobID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing synchronization job ID: %v", err)
+	}
+
+	resp, err := client.GetSynchronizationSchema(ctx, *id, synchronizationClient.SynchronizationSchemaOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving schema for %s", id)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r SynchronizationJobSchemaResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_synchronization_job" "test" {
+  service_principal_id = data.azuread_service_principal.test.id
+  template_id          = "dataBricks"
+  enabled              = false
+}
+`, SynchronizationJobResource{}.template(data))
+}
+
+func (r SynchronizationJobSchemaResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_synchronization_job_schema" "test" {
+  synchronization_job_id = azuread_synchronization_job.test.id
+
+  synchronization_rule {
+    id = "%[2]s"
+
+    object_mapping {
+      source_object_name = "User"
+      target_object_name = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+
+      attribute_mapping {
+        target_attribute_name = "userName"
+        source_attribute_name = "userPrincipalName"
+        matching_priority     = 1
+      }
+
+      attribute_mapping {
+        target_attribute_name = "displayName"
+        source_attribute_name = "displayName"
+      }
+
+      attribute_mapping {
+        target_attribute_name = "active"
+        expression            = "Switch([IsSoftDeleted], , \"False\", \"True\", \"True\", \"False\")"
+      }
+    }
+  }
+}
+`, r.template(data), r.RuleId)
+}
+
+func (r SynchronizationJobSchemaResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_synchronization_job_schema" "test" {
+  synchronization_job_id = azuread_synchronization_job.test.id
+
+  synchronization_rule {
+    id = "%[2]s"
+
+    object_mapping {
+      source_object_name = "User"
+      target_object_name = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+      flow_types         = ["Add", "Update"]
+
+      scoping_filter {
+        name = "acctest-%[3]d"
+
+        clause {
+          source_operand_name = "department"
+          operator_name       = "EQUALS"
+          target_values       = ["Engineering"]
+        }
+
+        clause {
+          source_operand_name = "accountEnabled"
+          operator_name       = "IS TRUE"
+        }
+      }
+
+      attribute_mapping {
+        target_attribute_name = "userName"
+        source_attribute_name = "userPrincipalName"
+        matching_priority     = 1
+      }
+
+      attribute_mapping {
+        target_attribute_name = "displayName"
+        source_attribute_name = "displayName"
+        default_value         = "unknown"
+        flow_behavior         = "FlowAlways"
+      }
+
+      attribute_mapping {
+        target_attribute_name = "active"
+        expression            = "Switch([IsSoftDeleted], , \"False\", \"True\", \"True\", \"False\")"
+      }
+
+      attribute_mapping {
+        target_attribute_name = "title"
+        constant_value        = "Employee"
+        flow_type             = "ObjectAddOnly"
+      }
+    }
+  }
+}
+`, r.template(data), r.RuleId, data.RandomInteger)
+}
@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applicationtemplates/stable/applicationtemplate"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationjob"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationsecret"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/synchronization/parse"
+)
+
+// nonGalleryApplicationTemplateId is the ID of the application template used for custom (non-gallery) applications
+const nonGalleryApplicationTemplateId = "8adf8e6e-67b2-4cf2-a259-e3dc5476c621"
+
+func synchronizationScimProvisioningResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: synchronizationScimProvisioningResourceCreate,
+		ReadContext:   synchronizationScimProvisioningResourceRead,
+		UpdateContext: synchronizationScimProvisioningResourceUpdate,
+		DeleteContext: synchronizationScimProvisioningResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(20 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ParseScimProvisioningID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "The display name of the application and service principal",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"base_address": {
+				Description:  "The URL of the SCIM endpoint to provision to",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"secret_token": {
+				Description:  "The bearer token used to authenticate to the SCIM endpoint",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"template_id": {
+				Description:  "The identifier of the synchronization template to use for the synchronization job",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "scim",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Description: "Whether or not the synchronization job is enabled",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+
+			"application_id": {
+				Description: "The resource ID of the application",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"application_object_id": {
+				Description: "The object ID of the application",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"client_id": {
+				Description: "The client ID of the application",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"service_principal_id": {
+				Description: "The resource ID of the service principal",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"service_principal_object_id": {
+				Description: "The object ID of the service principal",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"synchronization_job_id": {
+				Description: "The resource ID of the synchronization job",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"status": synchronizationJobStatusSchema(),
+		},
+	}
+}
+
+func synchronizationScimProvisioningCredentials(d *pluginsdk.ResourceData) *[]stable.SynchronizationSecretKeyStringValuePair {
+	return &[]stable.SynchronizationSecretKeyStringValuePair{
+		{
+			Key:   pointer.To(stable.SynchronizationSecret_BaseAddress),
+			Value: nullable.Value(d.Get("base_address").(string)),
+		},
+		{
+			Key:   pointer.To(stable.SynchronizationSecret_SecretToken),
+			Value: nullable.Value(d.Get("secret_token").(string)),
+		},
+	}
+}
+
+// synchronizationScimProvisioningConfigure validates the credentials against the SCIM endpoint before saving them, so
+// that the job is never started with credentials that are known not to work
+func synchronizationScimProvisioningConfigure(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id parse.ScimProvisioningId) pluginsdk.Diagnostics {
+	jobClient := meta.(*clients.Client).Synchronization.SynchronizationJobClient
+	secretClient := meta.(*clients.Client).Synchronization.SynchronizationSecretClient
+
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+	jobId := stable.NewServicePrincipalIdSynchronizationJobID(id.ServicePrincipalId, id.JobId)
+	credentials := synchronizationScimProvisioningCredentials(d)
+
+	if _, err := jobClient.ValidateSynchronizationJobCredentials(ctx, jobId, synchronizationjob.ValidateSynchronizationJobCredentialsRequest{
+		Credentials: credentials,
+	}, synchronizationjob.ValidateSynchronizationJobCredentialsOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+		return tf.ErrorDiagPathF(err, "base_address", "Validating credentials for %s (check that `base_address` is reachable and `secret_token` is accepted by the SCIM endpoint)", jobId)
+	}
+
+	if _, err := secretClient.SetSynchronizationSecret(ctx, servicePrincipalId, synchronizationsecret.SetSynchronizationSecretRequest{
+		Value: credentials,
+	}, synchronizationsecret.SetSynchronizationSecretOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+		return tf.ErrorDiagF(err, "Saving credentials for %s", servicePrincipalId)
+	}
+
+	return nil
+}
+
+func synchronizationScimProvisioningResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	templateClient := meta.(*clients.Client).Applications.ApplicationTemplateClient
+	applicationClient := meta.(*clients.Client).Applications.ApplicationClient
+	servicePrincipalClient := meta.(*clients.Client).Synchronization.ServicePrincipalClient
+	jobClient := meta.(*clients.Client).Synchronization.SynchronizationJobClient
+
+	displayName := d.Get("display_name").(string)
+	templateId := stable.NewApplicationTemplateID(nonGalleryApplicationTemplateId)
+
+	instantiateResp, err := templateClient.Instantiate(ctx, templateId, applicationtemplate.InstantiateRequest{
+		DisplayName: nullable.Value(displayName),
+	}, applicationtemplate.DefaultInstantiateOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating application %q from %s", displayName, templateId)
+	}
+	if instantiateResp.Model == nil || instantiateResp.Model.Application == nil || instantiateResp.Model.Application.Id == nil ||
+		instantiateResp.Model.ServicePrincipal == nil || instantiateResp.Model.ServicePrincipal.Id == nil {
+		return tf.ErrorDiagF(errors.New("application or service principal was nil"), "API error creating application %q from %s", displayName, templateId)
+	}
+
+	applicationId := stable.NewApplicationID(*instantiateResp.Model.Application.Id)
+	servicePrincipalId := stable.NewServicePrincipalID(*instantiateResp.Model.ServicePrincipal.Id)
+
+	// Remove the application if the synchronization job cannot be created, since it is not yet tracked in state
+	rollback := func(diags pluginsdk.Diagnostics) pluginsdk.Diagnostics {
+		if _, err := applicationClient.DeleteApplication(ctx, applicationId, application.DefaultDeleteApplicationOperationOptions()); err != nil {
+			log.Printf("[WARN] Failed to delete %s after a failed SCIM provisioning setup: %+v", applicationId, err)
+		}
+		return diags
+	}
+
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := servicePrincipalClient.GetServicePrincipal(ctx, servicePrincipalId, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return rollback(tf.ErrorDiagF(err, "Waiting for replication of %s", servicePrincipalId))
+	}
+
+	tf.LockByName(servicePrincipalResourceName, servicePrincipalId.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, servicePrincipalId.ServicePrincipalId)
+
+	jobResp, err := jobClient.CreateSynchronizationJob(ctx, servicePrincipalId, stable.SynchronizationJob{
+		TemplateId: nullable.Value(d.Get("template_id").(string)),
+	}, synchronizationjob.CreateSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		return rollback(tf.ErrorDiagPathF(err, "template_id", "Creating synchronization job for %s", servicePrincipalId))
+	}
+	if jobResp.Model == nil || jobResp.Model.Id == nil {
+		return rollback(tf.ErrorDiagF(errors.New("nil or empty id received"), "API error creating synchronization job for %s", servicePrincipalId))
+	}
+
+	id := parse.NewScimProvisioningID(applicationId.ApplicationId, servicePrincipalId.ServicePrincipalId, *jobResp.Model.Id)
+	jobId := stable.NewServicePrincipalIdSynchronizationJobID(id.ServicePrincipalId, id.JobId)
+
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := jobClient.GetSynchronizationJob(ctx, jobId, synchronizationjob.DefaultGetSynchronizationJobOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return rollback(tf.ErrorDiagF(err, "Waiting for creation of %s", jobId))
+	}
+
+	// From this point, failures leave the resource tainted so that it is recreated on the next apply
+	d.SetId(id.ID())
+
+	if diags := synchronizationScimProvisioningConfigure(ctx, d, meta, id); diags != nil {
+		return diags
+	}
+
+	if d.Get("enabled").(bool) {
+		if _, err = jobClient.StartSynchronizationJob(ctx, jobId, synchronizationjob.StartSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+			return tf.ErrorDiagF(err, "Starting %s", jobId)
+		}
+	}
+
+	return synchronizationScimProvisioningResourceRead(ctx, d, meta)
+}
+
+func synchronizationScimProvisioningResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	applicationClient := meta.(*clients.Client).Applications.ApplicationClient
+	servicePrincipalClient := meta.(*clients.Client).Synchronization.ServicePrincipalClient
+	jobClient := meta.(*clients.Client).Synchronization.SynchronizationJobClient
+
+	id, err := parse.ParseScimProvisioningID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing SCIM provisioning ID %q", d.Id())
+	}
+
+	applicationId := stable.NewApplicationID(id.ApplicationId)
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+	jobId := stable.NewServicePrincipalIdSynchronizationJobID(id.ServicePrincipalId, id.JobId)
+
+	if d.HasChange("display_name") {
+		displayName := d.Get("display_name").(string)
+
+		if _, err = applicationClient.UpdateApplication(ctx, applicationId, stable.Application{
+			DisplayName: nullable.Value(displayName),
+		}, application.DefaultUpdateApplicationOperationOptions()); err != nil {
+			return tf.ErrorDiagPathF(err, "display_name", "Updating display name for %s", applicationId)
+		}
+
+		if _, err = servicePrincipalClient.UpdateServicePrincipal(ctx, servicePrincipalId, stable.ServicePrincipal{
+			DisplayName: nullable.Value(displayName),
+		}, serviceprincipal.DefaultUpdateServicePrincipalOperationOptions()); err != nil {
+			return tf.ErrorDiagPathF(err, "display_name", "Updating display name for %s", servicePrincipalId)
+		}
+	}
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	if d.HasChanges("base_address", "secret_token") {
+		if diags := synchronizationScimProvisioningConfigure(ctx, d, meta, *id); diags != nil {
+			return diags
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if d.Get("enabled").(bool) {
+			if _, err = jobClient.StartSynchronizationJob(ctx, jobId, synchronizationjob.StartSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+				return tf.ErrorDiagF(err, "Starting %s", jobId)
+			}
+		} else {
+			if _, err = jobClient.PauseSynchronizationJob(ctx, jobId, synchronizationjob.PauseSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+				return tf.ErrorDiagF(err, "Pausing %s", jobId)
+			}
+		}
+	}
+
+	return synchronizationScimProvisioningResourceRead(ctx, d, meta)
+}
+
+func synchronizationScimProvisioningResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	servicePrincipalClient := meta.(*clients.Client).Synchronization.ServicePrincipalClient
+	jobClient := meta.(*clients.Client).Synchronization.SynchronizationJobClient
+	secretClient := meta.(*clients.Client).Synchronization.SynchronizationSecretClient
+
+	id, err := parse.ParseScimProvisioningID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing SCIM provisioning ID %q", d.Id())
+	}
+
+	applicationId := stable.NewApplicationID(id.ApplicationId)
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+	jobId := stable.NewServicePrincipalIdSynchronizationJobID(id.ServicePrincipalId, id.JobId)
+
+	servicePrincipalResp, err := servicePrincipalClient.GetServicePrincipal(ctx, servicePrincipalId, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	if err != nil {
+		if response.WasNotFound(servicePrincipalResp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", servicePrincipalId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", servicePrincipalId)
+	}
+	servicePrincipal := servicePrincipalResp.Model
+	if servicePrincipal == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", servicePrincipalId)
+	}
+
+	jobResp, err := jobClient.GetSynchronizationJob(ctx, jobId, synchronizationjob.GetSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		if response.WasNotFound(jobResp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", jobId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", jobId)
+	}
+	job := jobResp.Model
+	if job == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", jobId)
+	}
+
+	secretsResp, err := secretClient.ListSynchronizationSecrets(ctx, servicePrincipalId, synchronizationsecret.ListSynchronizationSecretsOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving credentials for %s", servicePrincipalId)
+	}
+	if secretsResp.Model != nil {
+		for _, secret := range *secretsResp.Model {
+			// The secret token is write-only, so only the base address can be read back
+			if pointer.From(secret.Key) == stable.SynchronizationSecret_BaseAddress {
+				tf.Set(d, "base_address", secret.Value.GetOrZero())
+			}
+		}
+	}
+
+	enabled := false
+	if job.Schedule != nil {
+		enabled = pointer.From(job.Schedule.State) == stable.SynchronizationScheduleState_Active
+	}
+
+	tf.Set(d, "display_name", servicePrincipal.DisplayName.GetOrZero())
+	tf.Set(d, "template_id", job.TemplateId.GetOrZero())
+	tf.Set(d, "enabled", enabled)
+	tf.Set(d, "application_id", applicationId.ID())
+	tf.Set(d, "application_object_id", applicationId.ApplicationId)
+	tf.Set(d, "client_id", servicePrincipal.AppId.GetOrZero())
+	tf.Set(d, "service_principal_id", servicePrincipalId.ID())
+	tf.Set(d, "service_principal_object_id", servicePrincipalId.ServicePrincipalId)
+	tf.Set(d, "synchronization_job_id", jobId.ID())
+	tf.Set(d, "status", flattenSynchronizationStatus(job.Status))
+
+	return nil
+}
+
+func synchronizationScimProvisioningResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	applicationClient := meta.(*clients.Client).Applications.ApplicationClient
+
+	id, err := parse.ParseScimProvisioningID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing SCIM provisioning ID %q", d.Id())
+	}
+
+	applicationId := stable.NewApplicationID(id.ApplicationId)
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	// Deleting the application also deletes the service principal, along with its synchronization job and secrets
+	if resp, err := applicationClient.DeleteApplication(ctx, applicationId, application.DefaultDeleteApplicationOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting %s", applicationId)
+	}
+
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := applicationClient.GetApplication(ctx, applicationId, application.DefaultGetApplicationOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", applicationId)
+	}
+
+	return nil
+}
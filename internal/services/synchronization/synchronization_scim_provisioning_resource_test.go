@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationjob"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/synchronization/parse"
+)
+
+type SynchronizationScimProvisioningResource struct {
+	BaseAddress string
+	SecretToken string
+}
+
+func TestAccSynchronizationScimProvisioning_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_scim_provisioning", "test")
+	r := newSynchronizationScimProvisioningResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("client_id").IsUuid(),
+				check.That(data.ResourceName).Key("service_principal_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("synchronization_job_id").Exists(),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("secret_token"),
+	})
+}
+
+func TestAccSynchronizationScimProvisioning_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_scim_provisioning", "test")
+	r := newSynchronizationScimProvisioningResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("secret_token"),
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("secret_token"),
+	})
+}
+
+func newSynchronizationScimProvisioningResource(t *testing.T) SynchronizationScimProvisioningResource {
+	baseAddress := os.Getenv("ARM_TEST_SCIM_BASE_ADDRESS")
+	secretToken := os.Getenv("ARM_TEST_SCIM_SECRET_TOKEN")
+	if baseAddress == "" || secretToken == "" {
+		t.Skip("ARM_TEST_SCIM_BASE_ADDRESS and ARM_TEST_SCIM_SECRET_TOKEN must be set to run this test")
+	}
+
+	return SynchronizationScimProvisioningResource{
+		BaseAddress: baseAddress,
+		SecretToken: secretToken,
+	}
+}
+
+func (r SynchronizationScimProvisioningResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Synchronization.SynchronizationJobClient
+
+	id, err := parse.ParseScimProvisioningID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	jobId := stable.NewServicePrincipalIdSynchronizationJobID(id.ServicePrincipalId, id.JobId)
+
+	resp, err := client.GetSynchronizationJob(ctx, jobId, synchronizationjob.DefaultGetSynchronizationJobOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s", jobId)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r SynchronizationScimProvisioningResource) basic(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_synchronization_scim_provisioning" "test" {
+  display_name = "acctestScimProvisioning-%[1]d"
+  base_address = "%[2]s"
+  secret_token = "%[3]s"
+  enabled      = %[4]t
+}
+`, data.RandomInteger, r.BaseAddress, r.SecretToken, enabled)
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	synchronizationClient "github.com/valiparsa/terraform-provider-azuread/internal/services/synchronization/client"
+)
+
+func synchronizationTemplatesDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: synchronizationTemplatesDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_id": {
+				Description:  "The ID of the service principal for which to list synchronization templates",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: stable.ValidateServicePrincipalID,
+			},
+
+			"templates": {
+				Description: "A list of synchronization templates",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The identifier of the synchronization template, for use as the `template_id` of a synchronization job",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"application_id": {
+							Description: "The identifier of the application this template belongs to",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"default": {
+							Description: "Whether this template is recommended to be used by default for the application",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+
+						"description": {
+							Description: "The description of the synchronization template",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"discoverable": {
+							Description: "Whether this template should appear in the collection of templates available for the application",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+
+						"factory_tag": {
+							Description: "The synchronization engine identifier, which determines the type of synchronization performed",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"metadata": {
+							Description: "Additional extension properties of the synchronization template",
+							Type:        pluginsdk.TypeMap,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"directory_names": {
+							Description: "The names of the directories defined in the default schema of the template",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"synchronization_rule": {
+							Description: "The synchronization rules defined in the default schema of the template",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"id": {
+										Description: "The identifier of the synchronization rule",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"name": {
+										Description: "The name of the synchronization rule",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"source_directory_name": {
+										Description: "The name of the source directory",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"target_directory_name": {
+										Description: "The name of the target directory",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func synchronizationTemplatesDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Synchronization.SynchronizationTemplateClient
+
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Get("service_principal_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Parsing `service_principal_id`")
+	}
+
+	resp, err := client.ListSynchronizationTemplates(ctx, *servicePrincipalId, synchronizationClient.ListSynchronizationTemplatesOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "service_principal_id", "%s was not found", servicePrincipalId)
+		}
+		return tf.ErrorDiagF(err, "Listing synchronization templates for %s", servicePrincipalId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Listing synchronization templates for %s", servicePrincipalId)
+	}
+
+	templates := make([]map[string]interface{}, 0)
+	for _, template := range *resp.Model {
+		metadata := make(map[string]interface{})
+		if template.Metadata != nil {
+			for _, entry := range *template.Metadata {
+				if entry.Key != nil {
+					metadata[string(*entry.Key)] = entry.Value.GetOrZero()
+				}
+			}
+		}
+
+		directoryNames := make([]string, 0)
+		rules := make([]map[string]interface{}, 0)
+		if template.Schema != nil {
+			if template.Schema.Directories != nil {
+				for _, directory := range *template.Schema.Directories {
+					directoryNames = append(directoryNames, directory.Name.GetOrZero())
+				}
+			}
+			if template.Schema.SynchronizationRules != nil {
+				for _, rule := range *template.Schema.SynchronizationRules {
+					rules = append(rules, map[string]interface{}{
+						"id":                    rule.Id.GetOrZero(),
+						"name":                  rule.Name.GetOrZero(),
+						"source_directory_name": rule.SourceDirectoryName.GetOrZero(),
+						"target_directory_name": rule.TargetDirectoryName.GetOrZero(),
+					})
+				}
+			}
+		}
+
+		templates = append(templates, map[string]interface{}{
+			"id":                   pointer.From(template.Id),
+			"application_id":       pointer.From(template.ApplicationId),
+			"default":              pointer.From(template.Default),
+			"description":          template.Description.GetOrZero(),
+			"discoverable":         pointer.From(template.Discoverable),
+			"factory_tag":          template.FactoryTag.GetOrZero(),
+			"metadata":             metadata,
+			"directory_names":      directoryNames,
+			"synchronization_rule": rules,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/synchronization/templates", servicePrincipalId.ID()))
+
+	tf.Set(d, "service_principal_id", servicePrincipalId.ID())
+	tf.Set(d, "templates", templates)
+
+	return nil
+}
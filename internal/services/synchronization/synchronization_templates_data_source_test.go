@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type SynchronizationTemplatesDataSource struct{}
+
+func TestAccSynchronizationTemplatesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_synchronization_templates", "test")
+	r := SynchronizationTemplatesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("templates.#").Exists(),
+				check.That(data.ResourceName).Key("templates.0.id").Exists(),
+				check.That(data.ResourceName).Key("templates.0.factory_tag").Exists(),
+			),
+		},
+	})
+}
+
+func (SynchronizationTemplatesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_synchronization_templates" "test" {
+  service_principal_id = data.azuread_service_principal.test.id
+}
+`, SynchronizationJobResource{}.template(data))
+}
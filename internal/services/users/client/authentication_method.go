@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/graphrequest"
+)
+
+// AuthenticationMethodClient manages the phone, email and temporary access pass authentication methods registered for
+// a user, and lists all of a user's registered methods, which are not yet covered by the SDK
+type AuthenticationMethodClient struct {
+	Client *msgraph.Client
+}
+
+func NewAuthenticationMethodClientWithBaseURI(api environments.Api) (*AuthenticationMethodClient, error) {
+	c, err := msgraph.NewClient(api, "authenticationmethod", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating AuthenticationMethodClient: %+v", err)
+	}
+
+	return &AuthenticationMethodClient{
+		Client: c,
+	}, nil
+}
+
+type AuthenticationMethodOperationOptions struct {
+	RetryFunc client.RequestRetryFunc
+}
+
+type ListAuthenticationMethodsOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.AuthenticationMethod
+}
+
+type ListPhoneMethodsOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.PhoneAuthenticationMethod
+}
+
+type PhoneMethodOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.PhoneAuthenticationMethod
+}
+
+type ListEmailMethodsOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.EmailAuthenticationMethod
+}
+
+type EmailMethodOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.EmailAuthenticationMethod
+}
+
+type TemporaryAccessPassMethodOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.TemporaryAccessPassAuthenticationMethod
+}
+
+type DeleteAuthenticationMethodOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+// ListAuthenticationMethods - List all the authentication methods registered for a user
+func (c AuthenticationMethodClient) ListAuthenticationMethods(ctx context.Context, id stable.UserId, options AuthenticationMethodOperationOptions) (result ListAuthenticationMethodsOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, fmt.Sprintf("%s/authentication/methods", id.ID()), nil, true, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValueImplementations(resp, stable.UnmarshalAuthenticationMethodImplementation)
+
+	return
+}
+
+// ListPhoneMethods - List the phone authentication methods registered for a user
+func (c AuthenticationMethodClient) ListPhoneMethods(ctx context.Context, id stable.UserId, options AuthenticationMethodOperationOptions) (result ListPhoneMethodsOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, fmt.Sprintf("%s/authentication/phoneMethods", id.ID()), nil, true, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValues[stable.PhoneAuthenticationMethod](resp)
+
+	return
+}
+
+// CreatePhoneMethod - Register a phone authentication method for a user
+func (c AuthenticationMethodClient) CreatePhoneMethod(ctx context.Context, id stable.UserId, input stable.PhoneAuthenticationMethod, options AuthenticationMethodOperationOptions) (result PhoneMethodOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPost, fmt.Sprintf("%s/authentication/phoneMethods", id.ID()), input, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.PhoneAuthenticationMethod
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// GetPhoneMethod - Retrieve a phone authentication method for a user
+func (c AuthenticationMethodClient) GetPhoneMethod(ctx context.Context, id stable.UserIdAuthenticationPhoneMethodId, options AuthenticationMethodOperationOptions) (result PhoneMethodOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, id.ID(), nil, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.PhoneAuthenticationMethod
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// UpdatePhoneMethod - Update the phone number of a phone authentication method for a user
+func (c AuthenticationMethodClient) UpdatePhoneMethod(ctx context.Context, id stable.UserIdAuthenticationPhoneMethodId, input stable.PhoneAuthenticationMethod, options AuthenticationMethodOperationOptions) (result PhoneMethodOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPatch, id.ID(), input, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+// DeletePhoneMethod - Remove a phone authentication method from a user
+func (c AuthenticationMethodClient) DeletePhoneMethod(ctx context.Context, id stable.UserIdAuthenticationPhoneMethodId, options AuthenticationMethodOperationOptions) (result DeleteAuthenticationMethodOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodDelete, id.ID(), nil, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+// ListEmailMethods - List the email authentication methods registered for a user
+func (c AuthenticationMethodClient) ListEmailMethods(ctx context.Context, id stable.UserId, options AuthenticationMethodOperationOptions) (result ListEmailMethodsOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, fmt.Sprintf("%s/authentication/emailMethods", id.ID()), nil, true, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValues[stable.EmailAuthenticationMethod](resp)
+
+	return
+}
+
+// CreateEmailMethod - Register an email authentication method for a user
+func (c AuthenticationMethodClient) CreateEmailMethod(ctx context.Context, id stable.UserId, input stable.EmailAuthenticationMethod, options AuthenticationMethodOperationOptions) (result EmailMethodOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPost, fmt.Sprintf("%s/authentication/emailMethods", id.ID()), input, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.EmailAuthenticationMethod
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// GetEmailMethod - Retrieve an email authentication method for a user
+func (c AuthenticationMethodClient) GetEmailMethod(ctx context.Context, id stable.UserIdAuthenticationEmailMethodId, options AuthenticationMethodOperationOptions) (result EmailMethodOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, id.ID(), nil, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.EmailAuthenticationMethod
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// UpdateEmailMethod - Update the email address of an email authentication method for a user
+func (c AuthenticationMethodClient) UpdateEmailMethod(ctx context.Context, id stable.UserIdAuthenticationEmailMethodId, input stable.EmailAuthenticationMethod, options AuthenticationMethodOperationOptions) (result EmailMethodOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPatch, id.ID(), input, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+// DeleteEmailMethod - Remove an email authentication method from a user
+func (c AuthenticationMethodClient) DeleteEmailMethod(ctx context.Context, id stable.UserIdAuthenticationEmailMethodId, options AuthenticationMethodOperationOptions) (result DeleteAuthenticationMethodOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodDelete, id.ID(), nil, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+// CreateTemporaryAccessPassMethod - Issue a temporary access pass for a user. The pass itself is only returned in the
+// response to this request.
+func (c AuthenticationMethodClient) CreateTemporaryAccessPassMethod(ctx context.Context, id stable.UserId, input stable.TemporaryAccessPassAuthenticationMethod, options AuthenticationMethodOperationOptions) (result TemporaryAccessPassMethodOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPost, fmt.Sprintf("%s/authentication/temporaryAccessPassMethods", id.ID()), input, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.TemporaryAccessPassAuthenticationMethod
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// GetTemporaryAccessPassMethod - Retrieve a temporary access pass for a user
+func (c AuthenticationMethodClient) GetTemporaryAccessPassMethod(ctx context.Context, id stable.UserIdAuthenticationTemporaryAccessPassMethodId, options AuthenticationMethodOperationOptions) (result TemporaryAccessPassMethodOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, id.ID(), nil, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.TemporaryAccessPassAuthenticationMethod
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// DeleteTemporaryAccessPassMethod - Revoke a temporary access pass for a user
+func (c AuthenticationMethodClient) DeleteTemporaryAccessPassMethod(ctx context.Context, id stable.UserIdAuthenticationTemporaryAccessPassMethodId, options AuthenticationMethodOperationOptions) (result DeleteAuthenticationMethodOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodDelete, id.ID(), nil, false, options)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+func (c AuthenticationMethodClient) execute(ctx context.Context, method, path string, input interface{}, paged bool, options AuthenticationMethodOperationOptions) (*client.Response, error) {
+	return graphrequest.Execute(ctx, c.Client, method, path, input, paged, graphrequest.Options{RetryFunc: options.RetryFunc})
+}
@@ -13,13 +13,22 @@ import (
 )
 
 type Client struct {
-	ManagerClient  *manager.ManagerClient
-	MeClient       *me.MeClient
-	UserClient     *user.UserClient
-	UserClientBeta *userBeta.UserClient
+	AuthenticationMethodClient *AuthenticationMethodClient
+	ManagerClient              *manager.ManagerClient
+	MeClient                   *me.MeClient
+	SponsorClient              *SponsorClient
+	SubscribedSkuClient        *SubscribedSkuClient
+	UserClient                 *user.UserClient
+	UserClientBeta             *userBeta.UserClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	authenticationMethodClient, err := NewAuthenticationMethodClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authenticationMethodClient.Client)
+
 	managerClient, err := manager.NewManagerClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -32,6 +41,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(meClient.Client)
 
+	sponsorClient, err := NewSponsorClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(sponsorClient.Client)
+
+	subscribedSkuClient, err := NewSubscribedSkuClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(subscribedSkuClient.Client)
+
 	userClient, err := user.NewUserClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -46,9 +67,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(userClientBeta.Client)
 
 	return &Client{
-		ManagerClient:  managerClient,
-		MeClient:       meClient,
-		UserClient:     userClient,
-		UserClientBeta: userClientBeta,
+		AuthenticationMethodClient: authenticationMethodClient,
+		ManagerClient:              managerClient,
+		MeClient:                   meClient,
+		SponsorClient:              sponsorClient,
+		SubscribedSkuClient:        subscribedSkuClient,
+		UserClient:                 userClient,
+		UserClientBeta:             userClientBeta,
 	}, nil
 }
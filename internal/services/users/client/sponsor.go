@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/graphrequest"
+)
+
+// SponsorClient manages the users and groups which sponsor a user, which are not yet covered by the SDK
+type SponsorClient struct {
+	Client *msgraph.Client
+}
+
+func NewSponsorClientWithBaseURI(api environments.Api) (*SponsorClient, error) {
+	c, err := msgraph.NewClient(api, "sponsor", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating SponsorClient: %+v", err)
+	}
+
+	return &SponsorClient{
+		Client: c,
+	}, nil
+}
+
+type SponsorOperationOptions struct {
+	RetryFunc client.RequestRetryFunc
+}
+
+type ListSponsorsOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.DirectoryObject
+}
+
+type SponsorRefOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+// ListSponsors - List the users and groups who are sponsors of a user
+func (c SponsorClient) ListSponsors(ctx context.Context, id stable.UserId, options SponsorOperationOptions) (result ListSponsorsOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodGet, fmt.Sprintf("%s/sponsors", id.ID()), nil, true, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValueImplementations(resp, stable.UnmarshalDirectoryObjectImplementation)
+
+	return
+}
+
+// AddSponsorRef - Add a user or group as a sponsor of a user
+func (c SponsorClient) AddSponsorRef(ctx context.Context, id stable.UserId, input stable.ReferenceCreate, options SponsorOperationOptions) (SponsorRefOperationResponse, error) {
+	return c.executeRef(ctx, http.MethodPost, fmt.Sprintf("%s/sponsors/$ref", id.ID()), input, options)
+}
+
+// RemoveSponsorRef - Remove a user or group as a sponsor of a user
+func (c SponsorClient) RemoveSponsorRef(ctx context.Context, id stable.UserIdSponsorId, options SponsorOperationOptions) (SponsorRefOperationResponse, error) {
+	return c.executeRef(ctx, http.MethodDelete, fmt.Sprintf("%s/$ref", id.ID()), nil, options)
+}
+
+func (c SponsorClient) executeRef(ctx context.Context, method, path string, input interface{}, options SponsorOperationOptions) (result SponsorRefOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, method, path, input, false, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/graphrequest"
+)
+
+// SubscribedSkuClient lists the commercial subscriptions acquired by the tenant, which are not yet covered by the SDK
+type SubscribedSkuClient struct {
+	Client *msgraph.Client
+}
+
+func NewSubscribedSkuClientWithBaseURI(api environments.Api) (*SubscribedSkuClient, error) {
+	c, err := msgraph.NewClient(api, "subscribedsku", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating SubscribedSkuClient: %+v", err)
+	}
+
+	return &SubscribedSkuClient{
+		Client: c,
+	}, nil
+}
+
+type ListSubscribedSkusOperationOptions struct {
+	RetryFunc client.RequestRetryFunc
+}
+
+type ListSubscribedSkusOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.SubscribedSku
+}
+
+// ListSubscribedSkus - List the commercial subscriptions that the tenant has acquired
+func (c SubscribedSkuClient) ListSubscribedSkus(ctx context.Context, options ListSubscribedSkusOperationOptions) (result ListSubscribedSkusOperationResponse, err error) {
+	resp, err := graphrequest.Execute(ctx, c.Client, http.MethodGet, "/subscribedSkus", nil, true, graphrequest.Options{RetryFunc: options.RetryFunc})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Model, err = graphrequest.UnmarshalValues[stable.SubscribedSku](resp)
+
+	return
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "fmt"
+
+type UserAuthenticationMethodId struct {
+	ObjectSubResourceId
+	UserId   string
+	MethodId string
+}
+
+func NewUserPhoneAuthenticationMethodID(userId, methodId string) UserAuthenticationMethodId {
+	return newUserAuthenticationMethodID(userId, "phoneMethod", methodId)
+}
+
+func UserPhoneAuthenticationMethodID(idString string) (*UserAuthenticationMethodId, error) {
+	return userAuthenticationMethodID(idString, "phoneMethod", "Phone Authentication Method")
+}
+
+func NewUserEmailAuthenticationMethodID(userId, methodId string) UserAuthenticationMethodId {
+	return newUserAuthenticationMethodID(userId, "emailMethod", methodId)
+}
+
+func UserEmailAuthenticationMethodID(idString string) (*UserAuthenticationMethodId, error) {
+	return userAuthenticationMethodID(idString, "emailMethod", "Email Authentication Method")
+}
+
+func NewUserTemporaryAccessPassID(userId, methodId string) UserAuthenticationMethodId {
+	return newUserAuthenticationMethodID(userId, "temporaryAccessPass", methodId)
+}
+
+func UserTemporaryAccessPassID(idString string) (*UserAuthenticationMethodId, error) {
+	return userAuthenticationMethodID(idString, "temporaryAccessPass", "Temporary Access Pass")
+}
+
+func newUserAuthenticationMethodID(userId, methodType, methodId string) UserAuthenticationMethodId {
+	return UserAuthenticationMethodId{
+		ObjectSubResourceId: NewObjectSubResourceID(userId, methodType, methodId),
+		UserId:              userId,
+		MethodId:            methodId,
+	}
+}
+
+func userAuthenticationMethodID(idString, methodType, description string) (*UserAuthenticationMethodId, error) {
+	id, err := ObjectSubResourceID(idString, methodType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s ID: %v", description, err)
+	}
+
+	return &UserAuthenticationMethodId{
+		ObjectSubResourceId: *id,
+		UserId:              id.objectId,
+		MethodId:            id.subId,
+	}, nil
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "fmt"
+
+type UserLicenseId struct {
+	ObjectSubResourceId
+	UserId string
+	SkuId  string
+}
+
+func NewUserLicenseID(userId, skuId string) UserLicenseId {
+	return UserLicenseId{
+		ObjectSubResourceId: NewObjectSubResourceID(userId, "license", skuId),
+		UserId:              userId,
+		SkuId:               skuId,
+	}
+}
+
+func UserLicenseID(idString string) (*UserLicenseId, error) {
+	id, err := ObjectSubResourceID(idString, "license")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse License ID: %v", err)
+	}
+
+	return &UserLicenseId{
+		ObjectSubResourceId: *id,
+		UserId:              id.objectId,
+		SkuId:               id.subId,
+	}, nil
+}
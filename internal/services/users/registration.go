@@ -28,8 +28,11 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_user":  userDataSource(),
-		"azuread_users": usersData(),
+		"azuread_subscribed_skus":             subscribedSkusDataSource(),
+		"azuread_user":                        userDataSource(),
+		"azuread_user_authentication_methods": userAuthenticationMethodsDataSource(),
+		"azuread_user_management_chain":       userManagementChainDataSource(),
+		"azuread_users":                       usersData(),
 	}
 }
 
@@ -37,5 +40,9 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azuread_user": userResource(),
+		"azuread_user_email_authentication_method": userEmailAuthenticationMethodResource(),
+		"azuread_user_license_assignment":          userLicenseAssignmentResource(),
+		"azuread_user_phone_authentication_method": userPhoneAuthenticationMethodResource(),
+		"azuread_user_temporary_access_pass":       userTemporaryAccessPassResource(),
 	}
 }
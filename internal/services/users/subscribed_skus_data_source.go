@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/users/client"
+)
+
+func subscribedSkusDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: subscribedSkusDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"sku_part_numbers": {
+				Description: "Only return SKUs with these part numbers, e.g. `ENTERPRISEPACK`",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"service_plan_ids": {
+				Description: "A mapping of service plan names to service plan IDs, for all service plans in the returned SKUs",
+				Type:        pluginsdk.TypeMap,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"sku_ids": {
+				Description: "A mapping of SKU part numbers to SKU IDs",
+				Type:        pluginsdk.TypeMap,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"skus": {
+				Description: "A list of SKUs subscribed to by the tenant",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"applies_to": {
+							Description: "The target class for the SKU, either `User` or `Company`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"capability_status": {
+							Description: "The status of the SKU, e.g. `Enabled`, `Warning`, `Suspended`, `Deleted` or `LockedOut`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"consumed_units": {
+							Description: "The number of licenses that have been assigned",
+							Type:        pluginsdk.TypeInt,
+							Computed:    true,
+						},
+
+						"enabled_units": {
+							Description: "The number of prepaid licenses that are enabled",
+							Type:        pluginsdk.TypeInt,
+							Computed:    true,
+						},
+
+						"service_plans": {
+							Description: "A list of service plans available with the SKU",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"applies_to": {
+										Description: "The object the service plan can be assigned to, either `User` or `Company`",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"provisioning_status": {
+										Description: "The provisioning status of the service plan",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"service_plan_id": {
+										Description: "The unique identifier of the service plan",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"service_plan_name": {
+										Description: "The name of the service plan",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+
+						"sku_id": {
+							Description: "The unique identifier for the SKU",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"sku_part_number": {
+							Description: "The part number of the SKU, e.g. `ENTERPRISEPACK`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"suspended_units": {
+							Description: "The number of prepaid licenses that are suspended",
+							Type:        pluginsdk.TypeInt,
+							Computed:    true,
+						},
+
+						"warning_units": {
+							Description: "The number of prepaid licenses that are in warning status",
+							Type:        pluginsdk.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func subscribedSkusDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	subscribedSkuClient := meta.(*clients.Client).Users.SubscribedSkuClient
+
+	resp, err := subscribedSkuClient.ListSubscribedSkus(ctx, client.ListSubscribedSkusOperationOptions{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing subscribed SKUs")
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+	}
+
+	partNumbers := tf.ExpandStringSlice(d.Get("sku_part_numbers").([]interface{}))
+
+	skuIds := make([]string, 0)
+	skuIdMap := make(map[string]string)
+	servicePlanIdMap := make(map[string]string)
+	skuList := make([]map[string]interface{}, 0)
+
+	for _, sku := range *resp.Model {
+		partNumber := sku.SkuPartNumber.GetOrZero()
+
+		if len(partNumbers) > 0 {
+			found := false
+			for _, v := range partNumbers {
+				if strings.EqualFold(v, partNumber) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+
+		servicePlans := make([]map[string]interface{}, 0)
+		if sku.ServicePlans != nil {
+			for _, plan := range *sku.ServicePlans {
+				servicePlanIdMap[plan.ServicePlanName.GetOrZero()] = plan.ServicePlanId.GetOrZero()
+				servicePlans = append(servicePlans, map[string]interface{}{
+					"applies_to":          plan.AppliesTo.GetOrZero(),
+					"provisioning_status": plan.ProvisioningStatus.GetOrZero(),
+					"service_plan_id":     plan.ServicePlanId.GetOrZero(),
+					"service_plan_name":   plan.ServicePlanName.GetOrZero(),
+				})
+			}
+		}
+
+		var enabledUnits, suspendedUnits, warningUnits int64
+		if sku.PrepaidUnits != nil {
+			enabledUnits = sku.PrepaidUnits.Enabled.GetOrZero()
+			suspendedUnits = sku.PrepaidUnits.Suspended.GetOrZero()
+			warningUnits = sku.PrepaidUnits.Warning.GetOrZero()
+		}
+
+		skuIds = append(skuIds, sku.SkuId.GetOrZero())
+		skuIdMap[partNumber] = sku.SkuId.GetOrZero()
+		skuList = append(skuList, map[string]interface{}{
+			"applies_to":        sku.AppliesTo.GetOrZero(),
+			"capability_status": sku.CapabilityStatus.GetOrZero(),
+			"consumed_units":    int(sku.ConsumedUnits.GetOrZero()),
+			"enabled_units":     int(enabledUnits),
+			"service_plans":     servicePlans,
+			"sku_id":            sku.SkuId.GetOrZero(),
+			"sku_part_number":   partNumber,
+			"suspended_units":   int(suspendedUnits),
+			"warning_units":     int(warningUnits),
+		})
+	}
+
+	for _, v := range partNumbers {
+		found := false
+		for k := range skuIdMap {
+			if strings.EqualFold(k, v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return tf.ErrorDiagPathF(nil, "sku_part_numbers", "No subscribed SKU found with part number %q", v)
+		}
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(skuIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for SKU IDs")
+	}
+
+	d.SetId("subscribedskus#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "service_plan_ids", servicePlanIdMap)
+	tf.Set(d, "sku_ids", skuIdMap)
+	tf.Set(d, "skus", skuList)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type SubscribedSkusDataSource struct{}
+
+func TestAccSubscribedSkusDataSource_all(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_subscribed_skus", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: SubscribedSkusDataSource{}.all(),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("skus.#").Exists(),
+			check.That(data.ResourceName).Key("sku_ids.%").Exists(),
+			check.That(data.ResourceName).Key("service_plan_ids.%").Exists(),
+		),
+	}})
+}
+
+func TestAccSubscribedSkusDataSource_byPartNumber(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_subscribed_skus", "test")
+	skuPartNumber := os.Getenv("ARM_TEST_LICENSE_SKU_PART_NUMBER")
+	if skuPartNumber == "" {
+		t.Skip("ARM_TEST_LICENSE_SKU_PART_NUMBER must be set to run this test")
+	}
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: SubscribedSkusDataSource{}.byPartNumber(skuPartNumber),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("skus.#").HasValue("1"),
+			check.That(data.ResourceName).Key("skus.0.sku_id").IsUuid(),
+			check.That(data.ResourceName).Key("skus.0.sku_part_number").HasValue(skuPartNumber),
+			check.That(data.ResourceName).Key(fmt.Sprintf("sku_ids.%s", skuPartNumber)).IsUuid(),
+		),
+	}})
+}
+
+func (SubscribedSkusDataSource) all() string {
+	return `
+data "azuread_subscribed_skus" "test" {}
+`
+}
+
+func (SubscribedSkusDataSource) byPartNumber(skuPartNumber string) string {
+	return fmt.Sprintf(`
+data "azuread_subscribed_skus" "test" {
+  sku_part_numbers = ["%[1]s"]
+}
+`, skuPartNumber)
+}
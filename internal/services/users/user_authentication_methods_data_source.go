@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	usersClient "github.com/valiparsa/terraform-provider-azuread/internal/services/users/client"
+)
+
+func userAuthenticationMethodsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: userAuthenticationMethodsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"user_object_id": {
+				Description:  "The object ID of the user",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"methods": {
+				Description: "A list of the authentication methods registered for the user",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The ID of the authentication method",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"type": {
+							Description: "The type of the authentication method, for example `email`, `fido2`, `password`, `phone` or `temporaryAccessPass`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"types": {
+				Description: "The distinct types of authentication method registered for the user",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func userAuthenticationMethodsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationMethodClient
+
+	userId := stable.NewUserID(d.Get("user_object_id").(string))
+
+	resp, err := client.ListAuthenticationMethods(ctx, userId, usersClient.AuthenticationMethodOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "user_object_id", "%s was not found", userId)
+		}
+		return tf.ErrorDiagF(err, "Listing authentication methods for %s", userId)
+	}
+
+	methods := make([]map[string]interface{}, 0)
+	methodIds := make([]string, 0)
+	types := make([]string, 0)
+	seenTypes := make(map[string]bool)
+
+	for _, method := range pointer.From(resp.Model) {
+		methodId := pointer.From(method.AuthenticationMethod().Id)
+		methodType := userAuthenticationMethodType(pointer.From(method.AuthenticationMethod().ODataType))
+
+		methodIds = append(methodIds, methodId)
+		methods = append(methods, map[string]interface{}{
+			"id":   methodId,
+			"type": methodType,
+		})
+
+		if !seenTypes[methodType] {
+			seenTypes[methodType] = true
+			types = append(types, methodType)
+		}
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(userId.UserId + "/" + strings.Join(methodIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for method IDs")
+	}
+
+	d.SetId("authenticationmethods#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "methods", methods)
+	tf.Set(d, "types", types)
+	tf.Set(d, "user_object_id", userId.UserId)
+
+	return nil
+}
+
+// userAuthenticationMethodType returns a short name for an authentication method OData type, for example `phone` for
+// `#microsoft.graph.phoneAuthenticationMethod`
+func userAuthenticationMethodType(odataType string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(odataType, "#microsoft.graph."), "AuthenticationMethod")
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type UserAuthenticationMethodsDataSource struct{}
+
+func TestAccUserAuthenticationMethodsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_user_authentication_methods", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: UserAuthenticationMethodsDataSource{}.basic(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("user_object_id").IsUuid(),
+			check.That(data.ResourceName).Key("methods.#").HasValue("3"),
+			check.That(data.ResourceName).Key("types.#").HasValue("3"),
+		),
+	}})
+}
+
+func (UserAuthenticationMethodsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_phone_authentication_method" "test" {
+  user_object_id = azuread_user.test.object_id
+  phone_type     = "mobile"
+  phone_number   = "+1 5555551234"
+}
+
+resource "azuread_user_email_authentication_method" "test" {
+  user_object_id = azuread_user.test.object_id
+  email_address  = "acctest.recovery.%[2]d@example.com"
+}
+
+data "azuread_user_authentication_methods" "test" {
+  user_object_id = azuread_user.test.object_id
+
+  depends_on = [
+    azuread_user_email_authentication_method.test,
+    azuread_user_phone_authentication_method.test,
+  ]
+}
+`, UserPhoneAuthenticationMethodResource{}.template(data), data.RandomInteger)
+}
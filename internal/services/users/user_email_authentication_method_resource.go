@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	usersClient "github.com/valiparsa/terraform-provider-azuread/internal/services/users/client"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/users/parse"
+)
+
+func userEmailAuthenticationMethodResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: userEmailAuthenticationMethodResourceCreate,
+		ReadContext:   userEmailAuthenticationMethodResourceRead,
+		UpdateContext: userEmailAuthenticationMethodResourceUpdate,
+		DeleteContext: userEmailAuthenticationMethodResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.UserEmailAuthenticationMethodID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"user_object_id": {
+				Description:  "The object ID of the user for which to register the email address",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"email_address": {
+				Description:  "The email address to register for self-service password reset",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsEmailAddress,
+			},
+		},
+	}
+}
+
+func userEmailAuthenticationMethodResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationMethodClient
+
+	userId := stable.NewUserID(d.Get("user_object_id").(string))
+
+	tf.LockByName(userResourceName, userId.UserId)
+	defer tf.UnlockByName(userResourceName, userId.UserId)
+
+	listResp, err := client.ListEmailMethods(ctx, userId, usersClient.AuthenticationMethodOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(listResp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "user_object_id", "%s was not found", userId)
+		}
+		return tf.ErrorDiagF(err, "Retrieving email authentication methods for %s", userId)
+	}
+	// Each user can only have one email authentication method
+	for _, existing := range pointer.From(listResp.Model) {
+		if existing.Id != nil {
+			return tf.ImportAsExistsDiag("azuread_user_email_authentication_method", parse.NewUserEmailAuthenticationMethodID(userId.UserId, *existing.Id).String())
+		}
+	}
+
+	properties := stable.EmailAuthenticationMethod{
+		EmailAddress: nullable.Value(d.Get("email_address").(string)),
+	}
+
+	resp, err := client.CreateEmailMethod(ctx, userId, properties, usersClient.AuthenticationMethodOperationOptions{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Registering email address for %s", userId)
+	}
+
+	method := resp.Model
+	if method == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil email authentication method"), "Bad API Response")
+	}
+	if method.Id == nil || *method.Id == "" {
+		return tf.ErrorDiagF(errors.New("API returned email authentication method with nil ID"), "Bad API Response")
+	}
+
+	id := stable.NewUserIdAuthenticationEmailMethodID(userId.UserId, *method.Id)
+	d.SetId(parse.NewUserEmailAuthenticationMethodID(id.UserId, id.EmailAuthenticationMethodId).String())
+
+	// Wait for the email address to be registered
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetEmailMethod(ctx, id, usersClient.AuthenticationMethodOperationOptions{})
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for registration of %s", id)
+	}
+
+	return userEmailAuthenticationMethodResourceRead(ctx, d, meta)
+}
+
+func userEmailAuthenticationMethodResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationMethodClient
+
+	resourceId, err := parse.UserEmailAuthenticationMethodID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Email Authentication Method ID %q", d.Id())
+	}
+	id := stable.NewUserIdAuthenticationEmailMethodID(resourceId.UserId, resourceId.MethodId)
+
+	tf.LockByName(userResourceName, id.UserId)
+	defer tf.UnlockByName(userResourceName, id.UserId)
+
+	properties := stable.EmailAuthenticationMethod{
+		EmailAddress: nullable.Value(d.Get("email_address").(string)),
+	}
+
+	if _, err = client.UpdateEmailMethod(ctx, id, properties, usersClient.AuthenticationMethodOperationOptions{}); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return userEmailAuthenticationMethodResourceRead(ctx, d, meta)
+}
+
+func userEmailAuthenticationMethodResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationMethodClient
+
+	resourceId, err := parse.UserEmailAuthenticationMethodID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Email Authentication Method ID %q", d.Id())
+	}
+	id := stable.NewUserIdAuthenticationEmailMethodID(resourceId.UserId, resourceId.MethodId)
+
+	resp, err := client.GetEmailMethod(ctx, id, usersClient.AuthenticationMethodOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	method := resp.Model
+	if method == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "email_address", method.EmailAddress.GetOrZero())
+	tf.Set(d, "user_object_id", id.UserId)
+
+	return nil
+}
+
+func userEmailAuthenticationMethodResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationMethodClient
+
+	resourceId, err := parse.UserEmailAuthenticationMethodID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Email Authentication Method ID %q", d.Id())
+	}
+	id := stable.NewUserIdAuthenticationEmailMethodID(resourceId.UserId, resourceId.MethodId)
+
+	tf.LockByName(userResourceName, id.UserId)
+	defer tf.UnlockByName(userResourceName, id.UserId)
+
+	if resp, err := client.DeleteEmailMethod(ctx, id, usersClient.AuthenticationMethodOperationOptions{}); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetEmailMethod(ctx, id, usersClient.AuthenticationMethodOperationOptions{}); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of %s", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	usersClient "github.com/valiparsa/terraform-provider-azuread/internal/services/users/client"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/users/parse"
+)
+
+type UserEmailAuthenticationMethodResource struct{}
+
+func TestAccUserEmailAuthenticationMethod_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_email_authentication_method", "test")
+	r := UserEmailAuthenticationMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "recovery"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("email_address").HasValue(fmt.Sprintf("acctest.recovery.%d@example.com", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("email_address").HasValue(fmt.Sprintf("acctest.updated.%d@example.com", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserEmailAuthenticationMethod_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_email_authentication_method", "test")
+	r := UserEmailAuthenticationMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "recovery"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r UserEmailAuthenticationMethodResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Users.AuthenticationMethodClient
+
+	id, err := parse.UserEmailAuthenticationMethodID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Email Authentication Method ID: %v", err)
+	}
+
+	resp, err := client.GetEmailMethod(ctx, stable.NewUserIdAuthenticationEmailMethodID(id.UserId, id.MethodId), usersClient.AuthenticationMethodOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve email authentication method %q for user %q: %+v", id.MethodId, id.UserId, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r UserEmailAuthenticationMethodResource) basic(data acceptance.TestData, prefix string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_email_authentication_method" "test" {
+  user_object_id = azuread_user.test.object_id
+  email_address  = "acctest.%[2]s.%[3]d@example.com"
+}
+`, UserPhoneAuthenticationMethodResource{}.template(data), prefix, data.RandomInteger)
+}
+
+func (r UserEmailAuthenticationMethodResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_email_authentication_method" "import" {
+  user_object_id = azuread_user_email_authentication_method.test.user_object_id
+  email_address  = azuread_user_email_authentication_method.test.email_address
+}
+`, r.basic(data, "recovery"))
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/users/parse"
+)
+
+func userLicenseAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: userLicenseAssignmentResourceCreate,
+		ReadContext:   userLicenseAssignmentResourceRead,
+		UpdateContext: userLicenseAssignmentResourceUpdate,
+		DeleteContext: userLicenseAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.UserLicenseID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"user_object_id": {
+				Description:  "The object ID of the user to which the license should be assigned",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"sku_id": {
+				Description:  "The unique identifier for the SKU to assign",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"disabled_plans": {
+				Description: "A set of service plan IDs to disable for the assigned license",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+		},
+	}
+}
+
+func userLicenseAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserClient
+
+	userId := stable.NewUserID(d.Get("user_object_id").(string))
+	skuId := d.Get("sku_id").(string)
+	resourceId := parse.NewUserLicenseID(userId.UserId, skuId)
+
+	tf.LockByName(userResourceName, userId.UserId)
+	defer tf.UnlockByName(userResourceName, userId.UserId)
+
+	existing, err := userGetDirectLicense(ctx, client, userId, skuId)
+	if err != nil {
+		if errors.Is(err, errUserNotFound) {
+			return tf.ErrorDiagPathF(nil, "user_object_id", "%s was not found", userId)
+		}
+		return tf.ErrorDiagF(err, "Retrieving license assignments for %s", userId)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsDiag("azuread_user_license_assignment", resourceId.String())
+	}
+
+	if diags := userAssignLicense(ctx, client, userId, skuId, tf.ExpandStringSlice(d.Get("disabled_plans").(*pluginsdk.Set).List())); diags != nil {
+		return diags
+	}
+
+	d.SetId(resourceId.String())
+
+	return userLicenseAssignmentResourceRead(ctx, d, meta)
+}
+
+func userLicenseAssignmentResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserClient
+
+	resourceId, err := parse.UserLicenseID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User License ID %q", d.Id())
+	}
+	userId := stable.NewUserID(resourceId.UserId)
+
+	tf.LockByName(userResourceName, userId.UserId)
+	defer tf.UnlockByName(userResourceName, userId.UserId)
+
+	// Assigning a license that is already assigned replaces its disabled plans
+	if diags := userAssignLicense(ctx, client, userId, resourceId.SkuId, tf.ExpandStringSlice(d.Get("disabled_plans").(*pluginsdk.Set).List())); diags != nil {
+		return diags
+	}
+
+	return userLicenseAssignmentResourceRead(ctx, d, meta)
+}
+
+func userLicenseAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserClient
+
+	resourceId, err := parse.UserLicenseID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User License ID %q", d.Id())
+	}
+	userId := stable.NewUserID(resourceId.UserId)
+
+	license, err := userGetDirectLicense(ctx, client, userId, resourceId.SkuId)
+	if err != nil && !errors.Is(err, errUserNotFound) {
+		return tf.ErrorDiagF(err, "Retrieving license assignments for %s", userId)
+	}
+	if license == nil {
+		log.Printf("[DEBUG] License %q for %s was not found - removing from state!", resourceId.SkuId, userId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "disabled_plans", tf.FlattenStringSlicePtr(license.DisabledPlans))
+	tf.Set(d, "sku_id", resourceId.SkuId)
+	tf.Set(d, "user_object_id", resourceId.UserId)
+
+	return nil
+}
+
+func userLicenseAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserClient
+
+	resourceId, err := parse.UserLicenseID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User License ID %q", d.Id())
+	}
+	userId := stable.NewUserID(resourceId.UserId)
+
+	tf.LockByName(userResourceName, userId.UserId)
+	defer tf.UnlockByName(userResourceName, userId.UserId)
+
+	properties := user.AssignLicenseRequest{
+		AddLicenses:    &[]stable.AssignedLicense{},
+		RemoveLicenses: &[]string{resourceId.SkuId},
+	}
+	if resp, err := client.AssignLicense(ctx, userId, properties, user.DefaultAssignLicenseOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing license %q from %s", resourceId.SkuId, userId)
+	}
+
+	// Wait for the license to be removed
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		license, err := userGetDirectLicense(ctx, client, userId, resourceId.SkuId)
+		if err != nil {
+			if errors.Is(err, errUserNotFound) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(license != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of license %q from %s", resourceId.SkuId, userId)
+	}
+
+	return nil
+}
+
+var errUserNotFound = errors.New("user was not found")
+
+// userGetDirectLicense returns the state of the license with the specified SKU ID when it is assigned directly to a
+// user, or nil if the SKU is not directly assigned. Licenses inherited through group membership are ignored. When the
+// user does not exist, errUserNotFound is returned.
+func userGetDirectLicense(ctx context.Context, client *user.UserClient, id stable.UserId, skuId string) (*stable.LicenseAssignmentState, error) {
+	options := user.GetUserOperationOptions{
+		Select: &[]string{"id", "licenseAssignmentStates"},
+	}
+	resp, err := client.GetUser(ctx, id, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, errUserNotFound
+		}
+		return nil, err
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", id)
+	}
+
+	if resp.Model.LicenseAssignmentStates != nil {
+		for _, state := range *resp.Model.LicenseAssignmentStates {
+			if strings.EqualFold(state.SkuId.GetOrZero(), skuId) && state.AssignedByGroup.GetOrZero() == "" {
+				return &state, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// userAssignLicense assigns a license directly to a user, then waits for the assignment to be reflected when reading
+// the user
+func userAssignLicense(ctx context.Context, client *user.UserClient, id stable.UserId, skuId string, disabledPlans []string) pluginsdk.Diagnostics {
+	properties := user.AssignLicenseRequest{
+		AddLicenses: &[]stable.AssignedLicense{{
+			SkuId:         nullable.Value(skuId),
+			DisabledPlans: &disabledPlans,
+		}},
+		RemoveLicenses: &[]string{},
+	}
+
+	if _, err := client.AssignLicense(ctx, id, properties, user.DefaultAssignLicenseOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Assigning license %q to %s", skuId, id)
+	}
+
+	if err := consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		license, err := userGetDirectLicense(ctx, client, id, skuId)
+		if err != nil {
+			return nil, err
+		}
+		if license == nil {
+			return pointer.To(false), nil
+		}
+		return pointer.To(len(pointer.From(license.DisabledPlans)) == len(disabledPlans)), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for license %q to be assigned to %s", skuId, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/users/parse"
+)
+
+type UserLicenseAssignmentResource struct {
+	SkuPartNumber string
+}
+
+func TestAccUserLicenseAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_license_assignment", "test")
+	r := UserLicenseAssignmentResource{SkuPartNumber: os.Getenv("ARM_TEST_LICENSE_SKU_PART_NUMBER")}
+	if r.SkuPartNumber == "" {
+		t.Skip("ARM_TEST_LICENSE_SKU_PART_NUMBER must be set to run this test")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("sku_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.disabledPlans(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disabled_plans.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r UserLicenseAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Users.UserClient
+
+	id, err := parse.UserLicenseID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing User License ID: %v", err)
+	}
+
+	options := user.GetUserOperationOptions{
+		Select: &[]string{"assignedLicenses"},
+	}
+	resp, err := client.GetUser(ctx, stable.NewUserID(id.UserId), options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve user with object ID %q: %+v", id.UserId, err)
+	}
+
+	if resp.Model != nil && resp.Model.AssignedLicenses != nil {
+		for _, license := range *resp.Model.AssignedLicenses {
+			if strings.EqualFold(license.SkuId.GetOrZero(), id.SkuId) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r UserLicenseAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+data "azuread_subscribed_skus" "test" {
+  sku_part_numbers = ["%[3]s"]
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUserLicense.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUserLicense-%[1]d"
+  password            = "%[2]s"
+  usage_location      = "US"
+}
+`, data.RandomInteger, data.RandomPassword, r.SkuPartNumber)
+}
+
+func (r UserLicenseAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_license_assignment" "test" {
+  user_object_id = azuread_user.test.object_id
+  sku_id         = data.azuread_subscribed_skus.test.skus[0].sku_id
+}
+`, r.template(data))
+}
+
+func (r UserLicenseAssignmentResource) disabledPlans(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_license_assignment" "test" {
+  user_object_id = azuread_user.test.object_id
+  sku_id         = data.azuread_subscribed_skus.test.skus[0].sku_id
+  disabled_plans = [data.azuread_subscribed_skus.test.skus[0].service_plans[0].service_plan_id]
+}
+`, r.template(data))
+}
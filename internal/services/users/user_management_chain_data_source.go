@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/manager"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+// maxManagementChainDepth guards against excessively deep, or misconfigured, management hierarchies
+const maxManagementChainDepth = 100
+
+func userManagementChainDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: userManagementChainDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"user_object_id": {
+				Description:  "The object ID of the user",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"manager_object_ids": {
+				Description: "The object IDs of the user's managers, starting with their direct manager and ending at the top of the management chain",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"managers": {
+				Description: "A list of the user's managers, starting with their direct manager and ending at the top of the management chain",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"display_name": {
+							Description: "The display name of the manager",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"object_id": {
+							Description: "The object ID of the manager",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"user_principal_name": {
+							Description: "The user principal name (UPN) of the manager, if the manager is a user",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func userManagementChainDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserClient
+	managerClient := meta.(*clients.Client).Users.ManagerClient
+
+	userId := stable.NewUserID(d.Get("user_object_id").(string))
+
+	resp, err := client.GetUser(ctx, userId, user.DefaultGetUserOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "user_object_id", "%s was not found", userId)
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", userId)
+	}
+
+	managers := make([]map[string]interface{}, 0)
+	managerIds := make([]string, 0)
+	seen := map[string]bool{userId.UserId: true}
+
+	// Follow the manager of each user in turn, until we reach a user without a manager
+	currentId := userId
+	for {
+		managerResp, err := managerClient.GetManager(ctx, currentId, manager.DefaultGetManagerOperationOptions())
+		if err != nil {
+			if response.WasNotFound(managerResp.HttpResponse) {
+				break
+			}
+			return tf.ErrorDiagF(err, "Retrieving manager for %s", currentId)
+		}
+
+		if managerResp.Model == nil {
+			return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving manager for %s", currentId)
+		}
+
+		managerId := pointer.From(managerResp.Model.DirectoryObject().Id)
+		if managerId == "" {
+			return tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for manager of %s is nil/empty", currentId)
+		}
+
+		if seen[managerId] {
+			return tf.ErrorDiagF(fmt.Errorf("manager %q appears more than once", managerId), "Management chain for %s contains a cycle", userId)
+		}
+		if len(managerIds) >= maxManagementChainDepth {
+			return tf.ErrorDiagF(fmt.Errorf("exceeded %d managers", maxManagementChainDepth), "Management chain for %s is too deep", userId)
+		}
+		seen[managerId] = true
+
+		displayName, userPrincipalName := "", ""
+		switch m := managerResp.Model.(type) {
+		case stable.User:
+			displayName = m.DisplayName.GetOrZero()
+			userPrincipalName = m.UserPrincipalName.GetOrZero()
+		case stable.OrgContact:
+			displayName = m.DisplayName.GetOrZero()
+		}
+
+		managerIds = append(managerIds, managerId)
+		managers = append(managers, map[string]interface{}{
+			"display_name":        displayName,
+			"object_id":           managerId,
+			"user_principal_name": userPrincipalName,
+		})
+
+		// Only users can themselves have a manager
+		if _, ok := managerResp.Model.(stable.User); !ok {
+			break
+		}
+		currentId = stable.NewUserID(managerId)
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(userId.UserId + "/" + strings.Join(managerIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for manager IDs")
+	}
+
+	d.SetId("managementchain#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "manager_object_ids", managerIds)
+	tf.Set(d, "managers", managers)
+	tf.Set(d, "user_object_id", userId.UserId)
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type UserManagementChainDataSource struct{}
+
+func TestAccUserManagementChainDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_user_management_chain", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: UserManagementChainDataSource{}.basic(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("user_object_id").IsUuid(),
+			check.That(data.ResourceName).Key("manager_object_ids.#").HasValue("2"),
+			check.That(data.ResourceName).Key("managers.#").HasValue("2"),
+			check.That(data.ResourceName).Key("managers.0.object_id").MatchesOtherKey(check.That("azuread_user.manager").Key("object_id")),
+			check.That(data.ResourceName).Key("managers.1.object_id").MatchesOtherKey(check.That("azuread_user.director").Key("object_id")),
+			check.That(data.ResourceName).Key("managers.1.display_name").HasValue(fmt.Sprintf("acctestUser-%d-Director", data.RandomInteger)),
+		),
+	}})
+}
+
+func TestAccUserManagementChainDataSource_noManager(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_user_management_chain", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: UserManagementChainDataSource{}.noManager(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("manager_object_ids.#").HasValue("0"),
+			check.That(data.ResourceName).Key("managers.#").HasValue("0"),
+		),
+	}})
+}
+
+func (UserManagementChainDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "director" {
+  user_principal_name = "acctestUser.%[1]d.Director@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d-Director"
+  password            = "%[2]s"
+}
+
+resource "azuread_user" "manager" {
+  user_principal_name = "acctestUser.%[1]d.Manager@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d-Manager"
+  password            = "%[2]s"
+  manager_id          = azuread_user.director.object_id
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  manager_id          = azuread_user.manager.object_id
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r UserManagementChainDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_user_management_chain" "test" {
+  user_object_id = azuread_user.test.object_id
+}
+`, r.template(data))
+}
+
+func (r UserManagementChainDataSource) noManager(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_user_management_chain" "test" {
+  user_object_id = azuread_user.director.object_id
+}
+`, r.template(data))
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	usersClient "github.com/valiparsa/terraform-provider-azuread/internal/services/users/client"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/users/parse"
+)
+
+func userPhoneAuthenticationMethodResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: userPhoneAuthenticationMethodResourceCreate,
+		ReadContext:   userPhoneAuthenticationMethodResourceRead,
+		UpdateContext: userPhoneAuthenticationMethodResourceUpdate,
+		DeleteContext: userPhoneAuthenticationMethodResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.UserPhoneAuthenticationMethodID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"user_object_id": {
+				Description:  "The object ID of the user for which to register the phone",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"phone_type": {
+				Description:  "The type of phone to register. Each user can have at most one phone of each type",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAuthenticationPhoneType(), false),
+			},
+
+			"phone_number": {
+				Description:  "The phone number, in the format `+{country code} {number}x{extension}`, with the extension being optional",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\+\d{1,3} \d+(x\d+)?$`), "must be in the format `+{country code} {number}x{extension}`, e.g. `+1 5555551234` or `+1 5555551234x123`"),
+			},
+
+			"sms_sign_in_state": {
+				Description: "Whether the phone is ready to be used for SMS sign-in",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func userPhoneAuthenticationMethodResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationMethodClient
+
+	userId := stable.NewUserID(d.Get("user_object_id").(string))
+	phoneType := stable.AuthenticationPhoneType(d.Get("phone_type").(string))
+
+	tf.LockByName(userResourceName, userId.UserId)
+	defer tf.UnlockByName(userResourceName, userId.UserId)
+
+	listResp, err := client.ListPhoneMethods(ctx, userId, usersClient.AuthenticationMethodOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(listResp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "user_object_id", "%s was not found", userId)
+		}
+		return tf.ErrorDiagF(err, "Retrieving phone authentication methods for %s", userId)
+	}
+	for _, existing := range pointer.From(listResp.Model) {
+		if pointer.From(existing.PhoneType) == phoneType && existing.Id != nil {
+			return tf.ImportAsExistsDiag("azuread_user_phone_authentication_method", parse.NewUserPhoneAuthenticationMethodID(userId.UserId, *existing.Id).String())
+		}
+	}
+
+	properties := stable.PhoneAuthenticationMethod{
+		PhoneNumber: nullable.Value(d.Get("phone_number").(string)),
+		PhoneType:   pointer.To(phoneType),
+	}
+
+	resp, err := client.CreatePhoneMethod(ctx, userId, properties, usersClient.AuthenticationMethodOperationOptions{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Registering %s phone for %s", phoneType, userId)
+	}
+
+	method := resp.Model
+	if method == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil phone authentication method"), "Bad API Response")
+	}
+	if method.Id == nil || *method.Id == "" {
+		return tf.ErrorDiagF(errors.New("API returned phone authentication method with nil ID"), "Bad API Response")
+	}
+
+	id := stable.NewUserIdAuthenticationPhoneMethodID(userId.UserId, *method.Id)
+	d.SetId(parse.NewUserPhoneAuthenticationMethodID(id.UserId, id.PhoneAuthenticationMethodId).String())
+
+	// Wait for the phone to be registered
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetPhoneMethod(ctx, id, usersClient.AuthenticationMethodOperationOptions{})
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for registration of %s", id)
+	}
+
+	return userPhoneAuthenticationMethodResourceRead(ctx, d, meta)
+}
+
+func userPhoneAuthenticationMethodResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationMethodClient
+
+	resourceId, err := parse.UserPhoneAuthenticationMethodID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Phone Authentication Method ID %q", d.Id())
+	}
+	id := stable.NewUserIdAuthenticationPhoneMethodID(resourceId.UserId, resourceId.MethodId)
+
+	tf.LockByName(userResourceName, id.UserId)
+	defer tf.UnlockByName(userResourceName, id.UserId)
+
+	properties := stable.PhoneAuthenticationMethod{
+		PhoneNumber: nullable.Value(d.Get("phone_number").(string)),
+		PhoneType:   pointer.To(stable.AuthenticationPhoneType(d.Get("phone_type").(string))),
+	}
+
+	if _, err = client.UpdatePhoneMethod(ctx, id, properties, usersClient.AuthenticationMethodOperationOptions{}); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return userPhoneAuthenticationMethodResourceRead(ctx, d, meta)
+}
+
+func userPhoneAuthenticationMethodResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationMethodClient
+
+	resourceId, err := parse.UserPhoneAuthenticationMethodID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Phone Authentication Method ID %q", d.Id())
+	}
+	id := stable.NewUserIdAuthenticationPhoneMethodID(resourceId.UserId, resourceId.MethodId)
+
+	resp, err := client.GetPhoneMethod(ctx, id, usersClient.AuthenticationMethodOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	method := resp.Model
+	if method == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "phone_number", method.PhoneNumber.GetOrZero())
+	tf.Set(d, "phone_type", string(pointer.From(method.PhoneType)))
+	tf.Set(d, "sms_sign_in_state", string(pointer.From(method.SmsSignInState)))
+	tf.Set(d, "user_object_id", id.UserId)
+
+	return nil
+}
+
+func userPhoneAuthenticationMethodResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationMethodClient
+
+	resourceId, err := parse.UserPhoneAuthenticationMethodID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Phone Authentication Method ID %q", d.Id())
+	}
+	id := stable.NewUserIdAuthenticationPhoneMethodID(resourceId.UserId, resourceId.MethodId)
+
+	tf.LockByName(userResourceName, id.UserId)
+	defer tf.UnlockByName(userResourceName, id.UserId)
+
+	if resp, err := client.DeletePhoneMethod(ctx, id, usersClient.AuthenticationMethodOperationOptions{}); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetPhoneMethod(ctx, id, usersClient.AuthenticationMethodOperationOptions{}); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of %s", id)
+	}
+
+	return nil
+}
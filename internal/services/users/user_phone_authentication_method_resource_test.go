@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	usersClient "github.com/valiparsa/terraform-provider-azuread/internal/services/users/client"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/users/parse"
+)
+
+type UserPhoneAuthenticationMethodResource struct{}
+
+func TestAccUserPhoneAuthenticationMethod_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_phone_authentication_method", "test")
+	r := UserPhoneAuthenticationMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "+1 5555551234"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("phone_number").HasValue("+1 5555551234"),
+				check.That(data.ResourceName).Key("phone_type").HasValue("mobile"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "+1 5555554321"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("phone_number").HasValue("+1 5555554321"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserPhoneAuthenticationMethod_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_phone_authentication_method", "test")
+	r := UserPhoneAuthenticationMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "+1 5555551234"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r UserPhoneAuthenticationMethodResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Users.AuthenticationMethodClient
+
+	id, err := parse.UserPhoneAuthenticationMethodID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Phone Authentication Method ID: %v", err)
+	}
+
+	resp, err := client.GetPhoneMethod(ctx, stable.NewUserIdAuthenticationPhoneMethodID(id.UserId, id.MethodId), usersClient.AuthenticationMethodOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve phone authentication method %q for user %q: %+v", id.MethodId, id.UserId, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (UserPhoneAuthenticationMethodResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUserAuth.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUserAuth-%[1]d"
+  password            = "%[2]s"
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r UserPhoneAuthenticationMethodResource) basic(data acceptance.TestData, phoneNumber string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_phone_authentication_method" "test" {
+  user_object_id = azuread_user.test.object_id
+  phone_type     = "mobile"
+  phone_number   = "%[2]s"
+}
+`, r.template(data), phoneNumber)
+}
+
+func (r UserPhoneAuthenticationMethodResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_phone_authentication_method" "import" {
+  user_object_id = azuread_user_phone_authentication_method.test.user_object_id
+  phone_type     = azuread_user_phone_authentication_method.test.phone_type
+  phone_number   = azuread_user_phone_authentication_method.test.phone_number
+}
+`, r.basic(data, "+1 5555551234"))
+}
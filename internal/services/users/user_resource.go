@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"strings"
 	"time"
 
@@ -24,9 +25,11 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/customsecurityattributes"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	usersClient "github.com/valiparsa/terraform-provider-azuread/internal/services/users/client"
 	"github.com/valiparsa/terraform-provider-azuread/internal/services/users/migrations"
 )
 
@@ -136,6 +139,8 @@ func userResource() *pluginsdk.Resource {
 				Optional:    true,
 			},
 
+			"custom_security_attributes": customsecurityattributes.Schema(),
+
 			"department": {
 				Description: "The name for the department in which the user works",
 				Type:        pluginsdk.TypeString,
@@ -169,6 +174,17 @@ func userResource() *pluginsdk.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 64),
 			},
 
+			"extension_attributes": {
+				Description:      "A mapping of on-premises extension attributes to set for the user, with keys from `extensionAttribute1` to `extensionAttribute15`. Read-only for users synced with Azure AD Connect",
+				Type:             pluginsdk.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.MapKeyMatch(regexp.MustCompile(`^extensionAttribute([1-9]|1[0-5])$`), "keys must be from `extensionAttribute1` to `extensionAttribute15`"),
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"force_password_change": {
 				Description: "Whether the user is forced to change the password during the next sign-in. Only takes effect when also changing the password",
 				Type:        pluginsdk.TypeBool,
@@ -284,6 +300,18 @@ func userResource() *pluginsdk.Resource {
 				Default:     true,
 			},
 
+			"sponsors": {
+				Description: "A set of object IDs of users or groups who are sponsors of this user",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Set:         pluginsdk.HashString,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
 			"state": {
 				Description: "The state or province in the user's address",
 				Type:        pluginsdk.TypeString,
@@ -410,6 +438,7 @@ func userResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 	client := meta.(*clients.Client).Users.UserClient
 	clientBeta := meta.(*clients.Client).Users.UserClientBeta
 	managerClient := meta.(*clients.Client).Users.ManagerClient
+	sponsorClient := meta.(*clients.Client).Users.SponsorClient
 
 	password := d.Get("password").(string)
 	if password == "" {
@@ -483,6 +512,15 @@ func userResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		properties.OnPremisesImmutableId = nullable.NoZero(v.(string))
 	}
 
+	if v, ok := d.GetOk("extension_attributes"); ok {
+		properties.OnPremisesExtensionAttributes = expandUserExtensionAttributes(v.(map[string]interface{}), nil)
+	}
+
+	customSecurityAttributes, err := customsecurityattributes.Expand(d.Get("custom_security_attributes").(*pluginsdk.Set).List(), nil)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "custom_security_attributes", "Could not expand custom security attributes")
+	}
+
 	if v, ok := d.GetOk("employee_hire_date"); ok {
 		_, err := time.Parse(time.RFC3339, v.(string))
 		if err != nil {
@@ -535,6 +573,21 @@ func userResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		}
 	}
 
+	for _, v := range d.Get("sponsors").(*pluginsdk.Set).List() {
+		sponsorRef := stable.ReferenceCreate{
+			ODataId: pointer.To(client.Client.BaseUri + stable.NewDirectoryObjectID(v.(string)).ID()),
+		}
+		if _, err = sponsorClient.AddSponsorRef(ctx, id, sponsorRef, usersClient.SponsorOperationOptions{}); err != nil {
+			return tf.ErrorDiagPathF(err, "sponsors", "Could not add sponsor %q for %s", v, id)
+		}
+	}
+
+	if len(customSecurityAttributes) > 0 {
+		if _, err = customsecurityattributes.Update(ctx, client.Client, &id, customSecurityAttributes); err != nil {
+			return tf.ErrorDiagPathF(err, "custom_security_attributes", "Could not assign custom security attributes for %s", id)
+		}
+	}
+
 	// Now ensure we can retrieve the user consistently
 	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
 		resp, err := client.GetUser(ctx, id, user.DefaultGetUserOperationOptions())
@@ -556,6 +609,7 @@ func userResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 	client := meta.(*clients.Client).Users.UserClient
 	clientBeta := meta.(*clients.Client).Users.UserClientBeta
 	managerClient := meta.(*clients.Client).Users.ManagerClient
+	sponsorClient := meta.(*clients.Client).Users.SponsorClient
 
 	id, err := stable.ParseUserID(d.Id())
 	if err != nil {
@@ -623,6 +677,11 @@ func userResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		properties.OnPremisesImmutableId = nullable.NoZero(d.Get("onpremises_immutable_id").(string))
 	}
 
+	if d.HasChange("extension_attributes") {
+		oldAttributes, newAttributes := d.GetChange("extension_attributes")
+		properties.OnPremisesExtensionAttributes = expandUserExtensionAttributes(newAttributes.(map[string]interface{}), oldAttributes.(map[string]interface{}))
+	}
+
 	if d.HasChange("show_in_address_list") {
 		properties.ShowInAddressList = nullable.NoZero(d.Get("show_in_address_list").(bool))
 	}
@@ -670,6 +729,38 @@ func userResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("sponsors") {
+		oldSponsors, newSponsors := d.GetChange("sponsors")
+		existingSponsors := tf.ExpandStringSlice(oldSponsors.(*pluginsdk.Set).List())
+		desiredSponsors := tf.ExpandStringSlice(newSponsors.(*pluginsdk.Set).List())
+
+		for _, sponsorId := range tf.Difference(existingSponsors, desiredSponsors) {
+			if _, err = sponsorClient.RemoveSponsorRef(ctx, stable.NewUserIdSponsorID(id.UserId, sponsorId), usersClient.SponsorOperationOptions{}); err != nil {
+				return tf.ErrorDiagPathF(err, "sponsors", "Could not remove sponsor %q for %s", sponsorId, id)
+			}
+		}
+
+		for _, sponsorId := range tf.Difference(desiredSponsors, existingSponsors) {
+			sponsorRef := stable.ReferenceCreate{
+				ODataId: pointer.To(client.Client.BaseUri + stable.NewDirectoryObjectID(sponsorId).ID()),
+			}
+			if _, err = sponsorClient.AddSponsorRef(ctx, *id, sponsorRef, usersClient.SponsorOperationOptions{}); err != nil {
+				return tf.ErrorDiagPathF(err, "sponsors", "Could not add sponsor %q for %s", sponsorId, id)
+			}
+		}
+	}
+
+	if d.HasChange("custom_security_attributes") {
+		oldAttributes, newAttributes := d.GetChange("custom_security_attributes")
+		customSecurityAttributes, err := customsecurityattributes.Expand(newAttributes.(*pluginsdk.Set).List(), oldAttributes.(*pluginsdk.Set).List())
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "custom_security_attributes", "Could not expand custom security attributes")
+		}
+		if _, err = customsecurityattributes.Update(ctx, client.Client, id, customSecurityAttributes); err != nil {
+			return tf.ErrorDiagPathF(err, "custom_security_attributes", "Could not assign custom security attributes for %s", id)
+		}
+	}
+
 	return userResourceRead(ctx, d, meta)
 }
 
@@ -677,6 +768,7 @@ func userResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta inter
 	client := meta.(*clients.Client).Users.UserClient
 	clientBeta := meta.(*clients.Client).Users.UserClientBeta
 	managerClient := meta.(*clients.Client).Users.ManagerClient
+	sponsorClient := meta.(*clients.Client).Users.SponsorClient
 
 	id, err := stable.ParseUserID(d.Id())
 	if err != nil {
@@ -737,6 +829,7 @@ func userResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta inter
 			"employeeType",
 			"faxNumber",
 			"mailNickname",
+			"onPremisesExtensionAttributes",
 			"onPremisesImmutableId",
 			"otherMails",
 			"passwordPolicies",
@@ -766,6 +859,7 @@ func userResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta inter
 	tf.Set(d, "employee_hire_date", uExtra.EmployeeHireDate.GetOrZero())
 	tf.Set(d, "employee_id", uExtra.EmployeeId.GetOrZero())
 	tf.Set(d, "employee_type", uExtra.EmployeeType.GetOrZero())
+	tf.Set(d, "extension_attributes", flattenUserExtensionAttributes(uExtra.OnPremisesExtensionAttributes))
 	tf.Set(d, "external_user_state", uExtra.ExternalUserState.GetOrZero())
 	tf.Set(d, "fax_number", uExtra.FaxNumber.GetOrZero())
 	tf.Set(d, "mail_nickname", uExtra.MailNickname.GetOrZero())
@@ -829,6 +923,31 @@ func userResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta inter
 
 	tf.Set(d, "manager_id", managerId)
 
+	sponsorsResp, err := sponsorClient.ListSponsors(ctx, *id, usersClient.SponsorOperationOptions{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve sponsors for %s", id)
+	}
+
+	sponsors := make([]string, 0)
+	if sponsorsResp.Model != nil {
+		for _, sponsor := range *sponsorsResp.Model {
+			if sponsorId := sponsor.DirectoryObject().Id; sponsorId != nil {
+				sponsors = append(sponsors, *sponsorId)
+			}
+		}
+	}
+
+	tf.Set(d, "sponsors", sponsors)
+
+	// Custom security attributes require additional permissions to read, so they are only retrieved when managed
+	if len(d.Get("custom_security_attributes").(*pluginsdk.Set).List()) > 0 {
+		_, customSecurityAttributes, err := customsecurityattributes.Get(ctx, client.Client, id)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve custom security attributes for %s", id)
+		}
+		tf.Set(d, "custom_security_attributes", customsecurityattributes.Flatten(customSecurityAttributes, d.Get("custom_security_attributes").(*pluginsdk.Set).List()))
+	}
+
 	return nil
 }
 
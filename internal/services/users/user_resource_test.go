@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -17,7 +18,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
 	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/testclient"
 	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	usersClient "github.com/valiparsa/terraform-provider-azuread/internal/services/users/client"
 )
 
 type UserResource struct{}
@@ -157,6 +161,92 @@ func TestAccUser_passwordInvalid(t *testing.T) {
 	})
 }
 
+func TestAccUser_customSecurityAttributes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customSecurityAttributes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_security_attributes.#").HasValue("3"),
+			),
+		},
+		{
+			Config: r.customSecurityAttributesUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_security_attributes.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccUser_sponsors(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sponsors(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sponsors.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.sponsorsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sponsors.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			// Sponsors are retained when no longer configured
+			Config: r.sponsorsRemoved(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sponsors.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+	})
+}
+
+func TestAccUser_sponsorsAddedOutOfBand(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sponsorsRemoved(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.addSponsor(data.ResourceName, "azuread_group.sponsor"),
+			),
+		},
+		{
+			Config: r.sponsorsRemoved(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sponsors.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.sponsors(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sponsors.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+	})
+}
+
 func (r UserResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Users.UserClient
 
@@ -175,6 +265,37 @@ func (r UserResource) Exists(ctx context.Context, clients *clients.Client, state
 	return pointer.To(true), nil
 }
 
+// addSponsor adds a sponsor to a user outside of Terraform
+func (UserResource) addSponsor(userResourceName, sponsorResourceName string) pluginsdk.TestCheckFunc {
+	return func(state *terraform.State) error {
+		userState, ok := state.RootModule().Resources[userResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", userResourceName)
+		}
+		sponsorState, ok := state.RootModule().Resources[sponsorResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", sponsorResourceName)
+		}
+
+		client, err := testclient.Build("")
+		if err != nil {
+			return fmt.Errorf("building client: %+v", err)
+		}
+		ctx, cancel := context.WithTimeout(client.StopContext, 5*time.Minute)
+		defer cancel()
+
+		id := stable.NewUserID(userState.Primary.ID)
+		sponsorRef := stable.ReferenceCreate{
+			ODataId: pointer.To(client.Users.SponsorClient.Client.BaseUri + stable.NewDirectoryObjectID(sponsorState.Primary.ID).ID()),
+		}
+		if _, err = client.Users.SponsorClient.AddSponsorRef(ctx, id, sponsorRef, usersClient.SponsorOperationOptions{}); err != nil {
+			return fmt.Errorf("adding sponsor %q for %s: %+v", sponsorState.Primary.ID, id, err)
+		}
+
+		return nil
+	}
+}
+
 func (UserResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
@@ -246,6 +367,11 @@ resource "azuread_user" "test" {
   city           = "acctestUser-%[1]d-City"
   country        = "acctestUser-%[1]d-Country"
   postal_code    = "111111"
+
+  extension_attributes = {
+    extensionAttribute1  = "acctestUser-%[1]d-Extension1"
+    extensionAttribute15 = "acctestUser-%[1]d-Extension15"
+  }
 }
 `, data.RandomInteger, data.RandomPassword, data.RandomString)
 }
@@ -334,3 +460,147 @@ resource "azuread_user" "test" {
 }
 `, data.RandomInteger, password)
 }
+
+func (UserResource) customSecurityAttributesTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_attribute_set" "test" {
+  name = "acctestUser%[1]s"
+}
+
+resource "azuread_custom_security_attribute_definition" "project" {
+  attribute_set = azuread_attribute_set.test.name
+  name          = "Project"
+  type          = "String"
+  collection    = true
+}
+
+resource "azuread_custom_security_attribute_definition" "level" {
+  attribute_set = azuread_attribute_set.test.name
+  name          = "Level"
+  type          = "Integer"
+}
+
+resource "azuread_custom_security_attribute_definition" "certified" {
+  attribute_set = azuread_attribute_set.test.name
+  name          = "Certified"
+  type          = "Boolean"
+}
+`, data.RandomString)
+}
+
+func (r UserResource) customSecurityAttributes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[2]d"
+  password            = "%[3]s"
+
+  custom_security_attributes {
+    attribute_set = azuread_attribute_set.test.name
+    name          = azuread_custom_security_attribute_definition.project.name
+    type          = "String"
+    values        = ["Alpine", "Baker"]
+  }
+
+  custom_security_attributes {
+    attribute_set = azuread_attribute_set.test.name
+    name          = azuread_custom_security_attribute_definition.level.name
+    type          = "Integer"
+    value         = "3"
+  }
+
+  custom_security_attributes {
+    attribute_set = azuread_attribute_set.test.name
+    name          = azuread_custom_security_attribute_definition.certified.name
+    type          = "Boolean"
+    value         = "true"
+  }
+}
+`, r.customSecurityAttributesTemplate(data), data.RandomInteger, data.RandomPassword)
+}
+
+func (r UserResource) customSecurityAttributesUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[2]d"
+  password            = "%[3]s"
+
+  custom_security_attributes {
+    attribute_set = azuread_attribute_set.test.name
+    name          = azuread_custom_security_attribute_definition.level.name
+    type          = "Integer"
+    value         = "4"
+  }
+}
+`, r.customSecurityAttributesTemplate(data), data.RandomInteger, data.RandomPassword)
+}
+
+func (UserResource) sponsorsTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "sponsor" {
+  user_principal_name = "acctestSponsor.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestSponsor-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "sponsor" {
+  display_name     = "acctestSponsor-%[1]d"
+  security_enabled = true
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r UserResource) sponsors(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[2]d"
+  password            = "%[3]s"
+  sponsors            = [azuread_user.sponsor.object_id, azuread_group.sponsor.object_id]
+}
+`, r.sponsorsTemplate(data), data.RandomInteger, data.RandomPassword)
+}
+
+func (r UserResource) sponsorsUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[2]d"
+  password            = "%[3]s"
+  sponsors            = [azuread_group.sponsor.object_id]
+}
+`, r.sponsorsTemplate(data), data.RandomInteger, data.RandomPassword)
+}
+
+func (r UserResource) sponsorsRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[2]d"
+  password            = "%[3]s"
+}
+`, r.sponsorsTemplate(data), data.RandomInteger, data.RandomPassword)
+}
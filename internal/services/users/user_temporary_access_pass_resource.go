@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/valiparsa/terraform-provider-azuread/internal/helpers/tf/validation"
+	usersClient "github.com/valiparsa/terraform-provider-azuread/internal/services/users/client"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/users/parse"
+)
+
+func userTemporaryAccessPassResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: userTemporaryAccessPassResourceCreate,
+		ReadContext:   userTemporaryAccessPassResourceRead,
+		DeleteContext: userTemporaryAccessPassResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.UserTemporaryAccessPassID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"user_object_id": {
+				Description:  "The object ID of the user for which to issue the temporary access pass",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"lifetime_in_minutes": {
+				Description:  "The lifetime of the temporary access pass in minutes, starting at `start_date`. Defaults to the value configured in the authentication methods policy",
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(10, 43200),
+			},
+
+			"start_date": {
+				Description:  "The date from which the temporary access pass is usable, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`). If this isn't specified, the pass is usable immediately",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"usable_once": {
+				Description: "Whether the temporary access pass can only be used once. Defaults to the value configured in the authentication methods policy",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+
+			"created_date": {
+				Description: "The date when the temporary access pass was created",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"temporary_access_pass": {
+				Description: "The temporary access pass, which is only available when it is issued",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+
+			"usable": {
+				Description: "Whether the temporary access pass is currently usable",
+				Type:        pluginsdk.TypeBool,
+				Computed:    true,
+			},
+
+			"usability_reason": {
+				Description: "The reason the temporary access pass is or is not usable, for example `EnabledByPolicy`, `Expired`, `NotYetValid` or `OneTimeUsed`",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func userTemporaryAccessPassResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationMethodClient
+
+	userId := stable.NewUserID(d.Get("user_object_id").(string))
+
+	tf.LockByName(userResourceName, userId.UserId)
+	defer tf.UnlockByName(userResourceName, userId.UserId)
+
+	properties := stable.TemporaryAccessPassAuthenticationMethod{}
+
+	if v, ok := d.GetOk("lifetime_in_minutes"); ok {
+		properties.LifetimeInMinutes = nullable.Value(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("start_date"); ok {
+		properties.StartDateTime = nullable.Value(v.(string))
+	}
+	if v, ok := d.GetOkExists("usable_once"); ok { //nolint:staticcheck // needed to detect unset booleans
+		properties.IsUsableOnce = nullable.Value(v.(bool))
+	}
+
+	resp, err := client.CreateTemporaryAccessPassMethod(ctx, userId, properties, usersClient.AuthenticationMethodOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "user_object_id", "%s was not found", userId)
+		}
+		return tf.ErrorDiagF(err, "Issuing temporary access pass for %s", userId)
+	}
+
+	method := resp.Model
+	if method == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil temporary access pass"), "Bad API Response")
+	}
+	if method.Id == nil || *method.Id == "" {
+		return tf.ErrorDiagF(errors.New("API returned temporary access pass with nil ID"), "Bad API Response")
+	}
+
+	id := stable.NewUserIdAuthenticationTemporaryAccessPassMethodID(userId.UserId, *method.Id)
+	d.SetId(parse.NewUserTemporaryAccessPassID(id.UserId, id.TemporaryAccessPassAuthenticationMethodId).String())
+
+	// The pass is only returned when it is issued, so it must be saved now
+	tf.Set(d, "temporary_access_pass", method.TemporaryAccessPass.GetOrZero())
+
+	// Wait for the pass to replicate
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetTemporaryAccessPassMethod(ctx, id, usersClient.AuthenticationMethodOperationOptions{})
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return userTemporaryAccessPassResourceRead(ctx, d, meta)
+}
+
+func userTemporaryAccessPassResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationMethodClient
+
+	resourceId, err := parse.UserTemporaryAccessPassID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Temporary Access Pass ID %q", d.Id())
+	}
+	id := stable.NewUserIdAuthenticationTemporaryAccessPassMethodID(resourceId.UserId, resourceId.MethodId)
+
+	resp, err := client.GetTemporaryAccessPassMethod(ctx, id, usersClient.AuthenticationMethodOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	method := resp.Model
+	if method == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "created_date", method.CreatedDateTime.GetOrZero())
+	tf.Set(d, "lifetime_in_minutes", int(method.LifetimeInMinutes.GetOrZero()))
+	tf.Set(d, "start_date", method.StartDateTime.GetOrZero())
+	tf.Set(d, "usability_reason", method.MethodUsabilityReason.GetOrZero())
+	tf.Set(d, "usable", method.IsUsable.GetOrZero())
+	tf.Set(d, "usable_once", method.IsUsableOnce.GetOrZero())
+	tf.Set(d, "user_object_id", id.UserId)
+
+	return nil
+}
+
+func userTemporaryAccessPassResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationMethodClient
+
+	resourceId, err := parse.UserTemporaryAccessPassID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Temporary Access Pass ID %q", d.Id())
+	}
+	id := stable.NewUserIdAuthenticationTemporaryAccessPassMethodID(resourceId.UserId, resourceId.MethodId)
+
+	tf.LockByName(userResourceName, id.UserId)
+	defer tf.UnlockByName(userResourceName, id.UserId)
+
+	if resp, err := client.DeleteTemporaryAccessPassMethod(ctx, id, usersClient.AuthenticationMethodOperationOptions{}); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Revoking %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetTemporaryAccessPassMethod(ctx, id, usersClient.AuthenticationMethodOperationOptions{}); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for revocation of %s", id)
+	}
+
+	return nil
+}
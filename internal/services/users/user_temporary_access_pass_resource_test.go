@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance"
+	"github.com/valiparsa/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/valiparsa/terraform-provider-azuread/internal/clients"
+	usersClient "github.com/valiparsa/terraform-provider-azuread/internal/services/users/client"
+	"github.com/valiparsa/terraform-provider-azuread/internal/services/users/parse"
+)
+
+type UserTemporaryAccessPassResource struct{}
+
+func TestAccUserTemporaryAccessPass_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_temporary_access_pass", "test")
+	r := UserTemporaryAccessPassResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("temporary_access_pass").Exists(),
+				check.That(data.ResourceName).Key("lifetime_in_minutes").Exists(),
+				check.That(data.ResourceName).Key("start_date").Exists(),
+			),
+		},
+		data.ImportStep("temporary_access_pass"),
+	})
+}
+
+func TestAccUserTemporaryAccessPass_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_temporary_access_pass", "test")
+	r := UserTemporaryAccessPassResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("temporary_access_pass").Exists(),
+				check.That(data.ResourceName).Key("lifetime_in_minutes").HasValue("60"),
+				check.That(data.ResourceName).Key("usable_once").HasValue("true"),
+				check.That(data.ResourceName).Key("usable").HasValue("false"),
+				check.That(data.ResourceName).Key("usability_reason").HasValue("NotYetValid"),
+			),
+		},
+		data.ImportStep("temporary_access_pass"),
+	})
+}
+
+func (r UserTemporaryAccessPassResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Users.AuthenticationMethodClient
+
+	id, err := parse.UserTemporaryAccessPassID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Temporary Access Pass ID: %v", err)
+	}
+
+	resp, err := client.GetTemporaryAccessPassMethod(ctx, stable.NewUserIdAuthenticationTemporaryAccessPassMethodID(id.UserId, id.MethodId), usersClient.AuthenticationMethodOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve temporary access pass %q for user %q: %+v", id.MethodId, id.UserId, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r UserTemporaryAccessPassResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_temporary_access_pass" "test" {
+  user_object_id = azuread_user.test.object_id
+}
+`, UserPhoneAuthenticationMethodResource{}.template(data))
+}
+
+func (r UserTemporaryAccessPassResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_temporary_access_pass" "test" {
+  user_object_id      = azuread_user.test.object_id
+  lifetime_in_minutes = 60
+  start_date          = "%[2]s"
+  usable_once         = true
+}
+`, UserPhoneAuthenticationMethodResource{}.template(data), time.Now().AddDate(0, 0, 1).UTC().Format(time.RFC3339))
+}
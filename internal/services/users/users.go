@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+)
+
+// expandUserExtensionAttributes returns the extension attributes in input, along with any attributes in previous which
+// have since been removed and are therefore cleared. Other attributes are left unspecified so that they are unchanged.
+func expandUserExtensionAttributes(input, previous map[string]interface{}) *stable.OnPremisesExtensionAttributes {
+	if len(input) == 0 && len(previous) == 0 {
+		return nil
+	}
+
+	result := stable.OnPremisesExtensionAttributes{}
+	fields := map[string]*nullable.Type[string]{
+		"extensionAttribute1":  &result.ExtensionAttribute1,
+		"extensionAttribute2":  &result.ExtensionAttribute2,
+		"extensionAttribute3":  &result.ExtensionAttribute3,
+		"extensionAttribute4":  &result.ExtensionAttribute4,
+		"extensionAttribute5":  &result.ExtensionAttribute5,
+		"extensionAttribute6":  &result.ExtensionAttribute6,
+		"extensionAttribute7":  &result.ExtensionAttribute7,
+		"extensionAttribute8":  &result.ExtensionAttribute8,
+		"extensionAttribute9":  &result.ExtensionAttribute9,
+		"extensionAttribute10": &result.ExtensionAttribute10,
+		"extensionAttribute11": &result.ExtensionAttribute11,
+		"extensionAttribute12": &result.ExtensionAttribute12,
+		"extensionAttribute13": &result.ExtensionAttribute13,
+		"extensionAttribute14": &result.ExtensionAttribute14,
+		"extensionAttribute15": &result.ExtensionAttribute15,
+	}
+
+	for key := range previous {
+		if field, ok := fields[key]; ok {
+			field.SetNull()
+		}
+	}
+
+	for key, value := range input {
+		if field, ok := fields[key]; ok {
+			v, _ := value.(string)
+			field.SetNoZero(v)
+		}
+	}
+
+	return &result
+}
+
+func flattenUserExtensionAttributes(input *stable.OnPremisesExtensionAttributes) map[string]interface{} {
+	result := make(map[string]interface{})
+	if input == nil {
+		return result
+	}
+
+	for key, value := range map[string]nullable.Type[string]{
+		"extensionAttribute1":  input.ExtensionAttribute1,
+		"extensionAttribute2":  input.ExtensionAttribute2,
+		"extensionAttribute3":  input.ExtensionAttribute3,
+		"extensionAttribute4":  input.ExtensionAttribute4,
+		"extensionAttribute5":  input.ExtensionAttribute5,
+		"extensionAttribute6":  input.ExtensionAttribute6,
+		"extensionAttribute7":  input.ExtensionAttribute7,
+		"extensionAttribute8":  input.ExtensionAttribute8,
+		"extensionAttribute9":  input.ExtensionAttribute9,
+		"extensionAttribute10": input.ExtensionAttribute10,
+		"extensionAttribute11": input.ExtensionAttribute11,
+		"extensionAttribute12": input.ExtensionAttribute12,
+		"extensionAttribute13": input.ExtensionAttribute13,
+		"extensionAttribute14": input.ExtensionAttribute14,
+		"extensionAttribute15": input.ExtensionAttribute15,
+	} {
+		if v := value.GetOrZero(); v != "" {
+			result[key] = v
+		}
+	}
+
+	return result
+}